@@ -30,6 +30,46 @@ type Board struct {
 	Name     string `json:"name,omitempty" structs:"name,omitemtpy"`
 	Type     string `json:"type,omitempty" structs:"type,omitempty"`
 	FilterID int    `json:"filterId,omitempty" structs:"filterId,omitempty"`
+	// Location is the project or user the board is located in
+	Location *BoardLocation `json:"location,omitempty" structs:"location,omitempty"`
+}
+
+// BoardLocation is the container a board is located in.
+// Type is "project" or "user", ProjectKeyOrID is required for project boards when creating one.
+type BoardLocation struct {
+	Type           string `json:"type,omitempty" structs:"type,omitempty"`
+	ProjectKeyOrID string `json:"projectKeyOrId,omitempty" structs:"projectKeyOrId,omitempty"`
+	ProjectID      int    `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	ProjectKey     string `json:"projectKey,omitempty" structs:"projectKey,omitempty"`
+	ProjectName    string `json:"projectName,omitempty" structs:"projectName,omitempty"`
+	UserID         int    `json:"userId,omitempty" structs:"userId,omitempty"`
+	DisplayName    string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Name           string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// CreateBoardWithFilterOptions are passed to the BoardService.CreateBoardWithFilter function
+type CreateBoardWithFilterOptions struct {
+	// Name of the board, must be less than 255 characters
+	Name string
+	// Type of the board, scrum or kanban
+	Type string
+	// FilterID is the ID of an existing filter to back the board with.
+	// If it is 0, the filter named FilterName is reused or created from JQL.
+	FilterID int
+	// FilterName defaults to Name
+	FilterName string
+	JQL        string
+	Location   *BoardLocation
+}
+
+// BoardDeletion reports what BoardService.DeleteBoardWithFilter removed
+type BoardDeletion struct {
+	BoardID  int
+	FilterID int
+	// FilterDeleted reports whether the filter backing the board was deleted
+	FilterDeleted bool
+	// SharedWith are the other boards backed by the filter, it is not deleted if there are any
+	SharedWith []Board
 }
 
 // BoardListOptions specifies the optional parameters to the BoardService.GetList
@@ -54,6 +94,71 @@ type GetAllSprintsOptions struct {
 	SearchOptions
 }
 
+// BoardIssueOptions specifies the optional parameters to the agile issue queries,
+// e.g. BoardService.GetIssues and EpicService.GetIssues
+type BoardIssueOptions struct {
+	// JQL filters the issues of the board further
+	JQL string `url:"jql,omitempty"`
+	// ValidateQuery rejects invalid JQL instead of ignoring the invalid parts
+	ValidateQuery bool `url:"validateQuery,omitempty"`
+	// Fields is the list of fields to return for each issue, all navigable fields if empty
+	Fields []string `url:"fields,comma,omitempty"`
+	// Expand: Expand specific sections in the returned issues
+	Expand     string `url:"expand,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// BoardEpicOptions specifies the optional parameters to the BoardService.GetEpics
+type BoardEpicOptions struct {
+	// Done filters results to epics that are either done or not done.
+	// Valid values: true, false.
+	Done string `url:"done,omitempty"`
+	// StartAt is the index of the first item returned, base index 0
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of items returned per page, default 50
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// EpicsList reflects a list of agile epics
+type EpicsList struct {
+	MaxResults int    `json:"maxResults" structs:"maxResults"`
+	StartAt    int    `json:"startAt" structs:"startAt"`
+	Total      int    `json:"total" structs:"total"`
+	IsLast     bool   `json:"isLast" structs:"isLast"`
+	Values     []Epic `json:"values" structs:"values"`
+}
+
+// QuickFilter is a quick filter of a board
+type QuickFilter struct {
+	ID          int    `json:"id" structs:"id"`
+	BoardID     int    `json:"boardId" structs:"boardId"`
+	Name        string `json:"name" structs:"name"`
+	JQL         string `json:"jql" structs:"jql"`
+	Description string `json:"description" structs:"description"`
+	Position    int    `json:"position" structs:"position"`
+}
+
+// QuickFiltersList reflects a list of quick filters of a board
+type QuickFiltersList struct {
+	MaxResults int           `json:"maxResults" structs:"maxResults"`
+	StartAt    int           `json:"startAt" structs:"startAt"`
+	Total      int           `json:"total" structs:"total"`
+	IsLast     bool          `json:"isLast" structs:"isLast"`
+	Values     []QuickFilter `json:"values" structs:"values"`
+}
+
+// PropertyKeys lists the keys of the properties of an entity, e.g. a board
+type PropertyKeys struct {
+	Keys []PropertyKey `json:"keys" structs:"keys"`
+}
+
+// PropertyKey is the key of a property of an entity
+type PropertyKey struct {
+	Self string `json:"self" structs:"self"`
+	Key  string `json:"key" structs:"key"`
+}
+
 // SprintsList reflects a list of agile sprints
 type SprintsList struct {
 	MaxResults int      `json:"maxResults" structs:"maxResults"`
@@ -73,6 +178,7 @@ type Sprint struct {
 	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
 	Self          string     `json:"self" structs:"self"`
 	State         string     `json:"state" structs:"state"`
+	Goal          string     `json:"goal" structs:"goal"`
 }
 
 // BoardConfiguration represents a boardConfiguration of a jira board
@@ -84,6 +190,26 @@ type BoardConfiguration struct {
 	Filter       BoardConfigurationFilter       `json:"filter"`
 	SubQuery     BoardConfigurationSubQuery     `json:"subQuery"`
 	ColumnConfig BoardConfigurationColumnConfig `json:"columnConfig"`
+	Estimation   BoardConfigurationEstimation   `json:"estimation"`
+	Ranking      BoardConfigurationRanking      `json:"ranking"`
+}
+
+// BoardConfigurationEstimation is how the issues of a board are estimated.
+// Type is "field" for a field such as story points, "issueCount" otherwise.
+type BoardConfigurationEstimation struct {
+	Type  string                            `json:"type"`
+	Field BoardConfigurationEstimationField `json:"field"`
+}
+
+// BoardConfigurationEstimationField is the field a board estimates issues with
+type BoardConfigurationEstimationField struct {
+	FieldID     string `json:"fieldId"`
+	DisplayName string `json:"displayName"`
+}
+
+// BoardConfigurationRanking is the field a board ranks issues with
+type BoardConfigurationRanking struct {
+	RankCustomFieldID int `json:"rankCustomFieldId"`
 }
 
 // BoardConfigurationFilter reference to the filter used by the given board.
@@ -113,10 +239,13 @@ type BoardConfigurationColumnConfig struct {
 	ConstraintType string                     `json:"constraintType"`
 }
 
-// BoardConfigurationColumn lists the name of the board with the statuses that maps to a particular column
+// BoardConfigurationColumn lists the name of the board with the statuses that maps to a particular column.
+// Min and Max are the column constraints, 0 if the column has none.
 type BoardConfigurationColumn struct {
 	Name   string                           `json:"name"`
 	Status []BoardConfigurationColumnStatus `json:"statuses"`
+	Min    int                              `json:"min,omitempty"`
+	Max    int                              `json:"max,omitempty"`
 }
 
 // BoardConfigurationColumnStatus represents a status in the column configuration
@@ -232,6 +361,128 @@ func (s *BoardService) DeleteBoard(boardID int) (*Board, *Response, error) {
 	return s.DeleteBoardWithContext(context.Background(), boardID)
 }
 
+// CreateBoardWithFilterWithContext creates a board backed by the filter given by options.
+// Without a FilterID, a filter of the user named FilterName is reused if it has the same JQL,
+// otherwise a new filter is created. A filter of that name with different JQL is an error.
+// The returned board has its FilterID set.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-createBoard
+func (s *BoardService) CreateBoardWithFilterWithContext(ctx context.Context, options *CreateBoardWithFilterOptions) (*Board, *Response, error) {
+	filterID := options.FilterID
+	if filterID == 0 {
+		filter, resp, err := s.boardFilter(ctx, options)
+		if err != nil {
+			return nil, resp, err
+		}
+		filterID, err = strconv.Atoi(filter.ID)
+		if err != nil {
+			return nil, resp, fmt.Errorf("jira: filter %q has no numeric id: %w", filter.Name, err)
+		}
+	}
+
+	board, resp, err := s.CreateBoardWithContext(ctx, &Board{
+		Name:     options.Name,
+		Type:     options.Type,
+		FilterID: filterID,
+		Location: options.Location,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	board.FilterID = filterID
+	return board, resp, nil
+}
+
+// CreateBoardWithFilter wraps CreateBoardWithFilterWithContext using the background context.
+func (s *BoardService) CreateBoardWithFilter(options *CreateBoardWithFilterOptions) (*Board, *Response, error) {
+	return s.CreateBoardWithFilterWithContext(context.Background(), options)
+}
+
+// boardFilter reuses or creates the filter to back a new board with
+func (s *BoardService) boardFilter(ctx context.Context, options *CreateBoardWithFilterOptions) (*Filter, *Response, error) {
+	name := options.FilterName
+	if name == "" {
+		name = options.Name
+	}
+
+	filters, resp, err := s.client.Filter.GetMyFiltersWithContext(ctx, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, f := range filters {
+		if f.Name != name {
+			continue
+		}
+		if f.Jql != options.JQL {
+			return nil, resp, fmt.Errorf("jira: filter %q exists with the JQL %q", name, f.Jql)
+		}
+		return f, resp, nil
+	}
+
+	return s.client.Filter.CreateWithContext(ctx, &CreateFilterOptions{Name: name, Jql: options.JQL})
+}
+
+// DeleteBoardWithFilterWithContext deletes a board and, if deleteFilter is set, the filter backing it.
+// The filter is kept if it backs other boards too, these are listed in BoardDeletion.SharedWith.
+// Nothing is deleted if the filter or the boards using it cannot be looked up. The boards of a
+// filter can only be listed on Jira Cloud, so on Jira Server and Data Center deleteFilter makes
+// this fail before anything is deleted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-rest-agile-1-0-board-filter-filterId-get
+func (s *BoardService) DeleteBoardWithFilterWithContext(ctx context.Context, boardID int, deleteFilter bool) (*BoardDeletion, *Response, error) {
+	config, resp, err := s.GetBoardConfigurationWithContext(ctx, boardID)
+	if err != nil {
+		return nil, resp, err
+	}
+	deletion := &BoardDeletion{BoardID: boardID}
+	if deletion.FilterID, err = strconv.Atoi(config.Filter.ID); err != nil {
+		return nil, resp, fmt.Errorf("jira: board %d has no numeric filter id: %w", boardID, err)
+	}
+
+	if deleteFilter {
+		for startAt := 0; ; {
+			apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/filter/%d?startAt=%d", deletion.FilterID, startAt)
+			req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			boards := new(BoardsList)
+			resp, err = s.client.Do(req, boards)
+			if err != nil {
+				return nil, resp, NewJiraError(resp, err)
+			}
+			for _, b := range boards.Values {
+				if b.ID != boardID {
+					deletion.SharedWith = append(deletion.SharedWith, b)
+				}
+			}
+			if boards.IsLast || len(boards.Values) == 0 {
+				break
+			}
+			startAt += len(boards.Values)
+		}
+	}
+
+	if _, resp, err = s.DeleteBoardWithContext(ctx, boardID); err != nil {
+		return nil, resp, err
+	}
+	if !deleteFilter || len(deletion.SharedWith) > 0 {
+		return deletion, resp, nil
+	}
+
+	resp, err = s.client.Filter.DeleteWithContext(ctx, deletion.FilterID)
+	if err != nil {
+		return deletion, resp, err
+	}
+	deletion.FilterDeleted = true
+	return deletion, resp, nil
+}
+
+// DeleteBoardWithFilter wraps DeleteBoardWithFilterWithContext using the background context.
+func (s *BoardService) DeleteBoardWithFilter(boardID int, deleteFilter bool) (*BoardDeletion, *Response, error) {
+	return s.DeleteBoardWithFilterWithContext(context.Background(), boardID, deleteFilter)
+}
+
 // GetAllSprintsWithContext will return all sprints from a board, for a given board Id.
 // This only includes sprints that the user has permission to view.
 //
@@ -309,3 +560,253 @@ func (s *BoardService) GetBoardConfigurationWithContext(ctx context.Context, boa
 func (s *BoardService) GetBoardConfiguration(boardID int) (*BoardConfiguration, *Response, error) {
 	return s.GetBoardConfigurationWithContext(context.Background(), boardID)
 }
+
+// getIssuesWithContext gets a page of the issues returned by an agile issue endpoint.
+// The paging values are set on the returned Response.
+func (s *BoardService) getIssuesWithContext(ctx context.Context, apiEndpoint string, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(searchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Issues, resp, nil
+}
+
+// GetIssuesWithContext returns a page of the issues of a board, ordered by rank.
+// The paging values are set on the returned Response.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board-getIssuesForBoard
+func (s *BoardService) GetIssuesWithContext(ctx context.Context, boardID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.getIssuesWithContext(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/issue", boardID), options)
+}
+
+// GetIssues wraps GetIssuesWithContext using the background context.
+func (s *BoardService) GetIssues(boardID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesWithContext(context.Background(), boardID, options)
+}
+
+// GetBackloggedIssuesWithContext returns a page of the issues in the backlog of a board,
+// i.e. the issues that are in no active or future sprint, ordered by rank.
+// The paging values are set on the returned Response.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board-getIssuesForBacklog
+func (s *BoardService) GetBackloggedIssuesWithContext(ctx context.Context, boardID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.getIssuesWithContext(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/backlog", boardID), options)
+}
+
+// GetBackloggedIssues wraps GetBackloggedIssuesWithContext using the background context.
+func (s *BoardService) GetBackloggedIssues(boardID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.GetBackloggedIssuesWithContext(context.Background(), boardID, options)
+}
+
+// GetEpicsWithContext returns a page of the epics of a board.
+// This is only supported for boards that support epics, e.g. scrum boards.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/epic-getEpics
+func (s *BoardService) GetEpicsWithContext(ctx context.Context, boardID int, options *BoardEpicOptions) (*EpicsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/epic", boardID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(EpicsList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// GetEpics wraps GetEpicsWithContext using the background context.
+func (s *BoardService) GetEpics(boardID int, options *BoardEpicOptions) (*EpicsList, *Response, error) {
+	return s.GetEpicsWithContext(context.Background(), boardID, options)
+}
+
+// GetIssuesForEpicWithContext returns a page of the issues of a board that belong to the epic identified by epicID.
+// The paging values are set on the returned Response.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/epic-getIssuesForEpic
+func (s *BoardService) GetIssuesForEpicWithContext(ctx context.Context, boardID, epicID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.getIssuesWithContext(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/epic/%d/issue", boardID, epicID), options)
+}
+
+// GetIssuesForEpic wraps GetIssuesForEpicWithContext using the background context.
+func (s *BoardService) GetIssuesForEpic(boardID, epicID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesForEpicWithContext(context.Background(), boardID, epicID, options)
+}
+
+// GetIssuesWithoutEpicWithContext returns a page of the issues of a board that belong to no epic.
+// The paging values are set on the returned Response.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/epic-getIssuesWithoutEpic
+func (s *BoardService) GetIssuesWithoutEpicWithContext(ctx context.Context, boardID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.getIssuesWithContext(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/epic/none/issue", boardID), options)
+}
+
+// GetIssuesWithoutEpic wraps GetIssuesWithoutEpicWithContext using the background context.
+func (s *BoardService) GetIssuesWithoutEpic(boardID int, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesWithoutEpicWithContext(context.Background(), boardID, options)
+}
+
+// GetPropertyKeysWithContext returns the keys of the properties of a board
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/properties-getPropertiesKeys
+func (s *BoardService) GetPropertyKeysWithContext(ctx context.Context, boardID int) (*PropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties", boardID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(PropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return keys, resp, nil
+}
+
+// GetPropertyKeys wraps GetPropertyKeysWithContext using the background context.
+func (s *BoardService) GetPropertyKeys(boardID int) (*PropertyKeys, *Response, error) {
+	return s.GetPropertyKeysWithContext(context.Background(), boardID)
+}
+
+// GetPropertyWithContext returns the property of a board identified by propertyKey
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/properties-getProperty
+func (s *BoardService) GetPropertyWithContext(ctx context.Context, boardID int, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties/%s", boardID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return property, resp, nil
+}
+
+// GetProperty wraps GetPropertyWithContext using the background context.
+func (s *BoardService) GetProperty(boardID int, propertyKey string) (*EntityProperty, *Response, error) {
+	return s.GetPropertyWithContext(context.Background(), boardID, propertyKey)
+}
+
+// SetPropertyWithContext sets the property of a board identified by propertyKey to value,
+// which is encoded as JSON. The property is created if it does not exist.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/properties-setProperty
+func (s *BoardService) SetPropertyWithContext(ctx context.Context, boardID int, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties/%s", boardID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// SetProperty wraps SetPropertyWithContext using the background context.
+func (s *BoardService) SetProperty(boardID int, propertyKey string, value interface{}) (*Response, error) {
+	return s.SetPropertyWithContext(context.Background(), boardID, propertyKey, value)
+}
+
+// DeletePropertyWithContext removes the property of a board identified by propertyKey
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board/{boardId}/properties-deleteProperty
+func (s *BoardService) DeletePropertyWithContext(ctx context.Context, boardID int, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties/%s", boardID, propertyKey)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteProperty wraps DeletePropertyWithContext using the background context.
+func (s *BoardService) DeleteProperty(boardID int, propertyKey string) (*Response, error) {
+	return s.DeletePropertyWithContext(context.Background(), boardID, propertyKey)
+}
+
+// GetQuickFiltersWithContext returns a page of the quick filters of a board, ordered by position
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-rest-agile-1-0-board-boardId-quickfilter-get
+func (s *BoardService) GetQuickFiltersWithContext(ctx context.Context, boardID int, options *SearchOptions) (*QuickFiltersList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/quickfilter", boardID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(QuickFiltersList)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// GetQuickFilters wraps GetQuickFiltersWithContext using the background context.
+func (s *BoardService) GetQuickFilters(boardID int, options *SearchOptions) (*QuickFiltersList, *Response, error) {
+	return s.GetQuickFiltersWithContext(context.Background(), boardID, options)
+}
+
+// GetQuickFilterWithContext returns the quick filter of a board identified by quickFilterID
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/#api-rest-agile-1-0-board-boardId-quickfilter-quickFilterId-get
+func (s *BoardService) GetQuickFilterWithContext(ctx context.Context, boardID, quickFilterID int) (*QuickFilter, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/quickfilter/%d", boardID, quickFilterID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(QuickFilter)
+	resp, err := s.client.Do(req, filter)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return filter, resp, nil
+}
+
+// GetQuickFilter wraps GetQuickFilterWithContext using the background context.
+func (s *BoardService) GetQuickFilter(boardID, quickFilterID int) (*QuickFilter, *Response, error) {
+	return s.GetQuickFilterWithContext(context.Background(), boardID, quickFilterID)
+}
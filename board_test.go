@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"testing"
 )
 
@@ -247,5 +249,391 @@ func TestBoardService_GetBoardConfigoration(t *testing.T) {
 	if len(boardConfiguration.ColumnConfig.Columns) != 6 {
 		t.Errorf("Expected 6 columns. go %d", len(boardConfiguration.ColumnConfig.Columns))
 	}
+	if max := boardConfiguration.ColumnConfig.Columns[1].Max; max != 20 {
+		t.Errorf("Expected a maximum of 20 issues. Got %d", max)
+	}
+	if field := boardConfiguration.Estimation.Field.FieldID; boardConfiguration.Estimation.Type != "field" || field != "customfield_10002" {
+		t.Errorf("Expected estimation by customfield_10002. Got %+v", boardConfiguration.Estimation)
+	}
+	if id := boardConfiguration.Ranking.RankCustomFieldID; id != 10002 {
+		t.Errorf("Expected rank field 10002. Got %d", id)
+	}
+
+}
+
+func TestBoardService_GetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"jql": "assignee = fred", "fields": "summary,status", "maxResults": "10"})
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 10, "total": 1, "issues": [{"id": "10040", "key": "PAY-22", "fields": {"summary": "Refund partially"}}]}`)
+	})
+
+	issues, resp, err := testClient.Board.GetIssues(35, &BoardIssueOptions{JQL: "assignee = fred", Fields: []string{"summary", "status"}, MaxResults: 10})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "PAY-22" || issues[0].Fields.Summary != "Refund partially" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+	if resp.Total != 1 || resp.MaxResults != 10 {
+		t.Errorf("Unexpected paging values %+v", resp)
+	}
+}
+
+func TestBoardService_GetBackloggedIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	raw, err := ioutil.ReadFile("./mocks/board_backlog.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc("/rest/agile/1.0/board/35/backlog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"startAt": "2", "maxResults": "2"})
+		fmt.Fprint(w, string(raw))
+	})
+
+	issues, resp, err := testClient.Board.GetBackloggedIssues(35, &BoardIssueOptions{StartAt: 2, MaxResults: 2})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if len(issues) != 2 || issues[1].Key != "PAY-24" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+	if resp.StartAt != 2 || resp.Total != 5 {
+		t.Errorf("Unexpected paging values %+v", resp)
+	}
+}
+
+func TestBoardService_GetEpics(t *testing.T) {
+	setup()
+	defer teardown()
+	raw, err := ioutil.ReadFile("./mocks/board_epics.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc("/rest/agile/1.0/board/35/epic", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"done": "false", "maxResults": "2"})
+		fmt.Fprint(w, string(raw))
+	})
+
+	epics, _, err := testClient.Board.GetEpics(35, &BoardEpicOptions{Done: "false", MaxResults: 2})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if epics == nil {
+		t.Fatal("Expected epic list. Got nil.")
+	}
+	if epics.IsLast || len(epics.Values) != 2 || epics.Values[0].Key != "PAY-12" || epics.Values[1].Name != "Invoices" {
+		t.Errorf("Unexpected epics %+v", epics)
+	}
+}
+
+func TestBoardService_GetIssuesForEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/epic/37/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/agile/1.0/board/35/epic/37/issue")
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 1, "issues": [{"id": "10040", "key": "PAY-22"}]}`)
+	})
+
+	issues, _, err := testClient.Board.GetIssuesForEpic(35, 37, nil)
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "PAY-22" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+}
+
+func TestBoardService_GetIssuesWithoutEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"jql": "status = Open"})
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 2, "issues": [{"id": "10043", "key": "PAY-25"}, {"id": "10044", "key": "PAY-26"}]}`)
+	})
+
+	issues, _, err := testClient.Board.GetIssuesWithoutEpic(35, &BoardIssueOptions{JQL: "status = Open"})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected 2 issues. Got %d", len(issues))
+	}
+}
+
+func TestBoardService_GetPropertyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"keys": [{"self": "http://www.example.com/jira/rest/agile/1.0/board/35/properties/burndown", "key": "burndown"}]}`)
+	})
+
+	keys, _, err := testClient.Board.GetPropertyKeys(35)
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if keys == nil || len(keys.Keys) != 1 || keys.Keys[0].Key != "burndown" {
+		t.Errorf("Unexpected keys %+v", keys)
+	}
+}
+
+func TestBoardService_GetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/properties/burndown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key": "burndown", "value": {"field": "customfield_10002"}}`)
+	})
+
+	property, _, err := testClient.Board.GetProperty(35, "burndown")
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if property == nil || property.Key != "burndown" {
+		t.Fatalf("Unexpected property %+v", property)
+	}
+	if value, ok := property.Value.(map[string]interface{}); !ok || value["field"] != "customfield_10002" {
+		t.Errorf("Unexpected value %+v", property.Value)
+	}
+}
 
+func TestBoardService_SetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/properties/burndown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"field":"customfield_10002"}`+"\n" {
+			t.Errorf("Unexpected body %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	if _, err := testClient.Board.SetProperty(35, "burndown", map[string]string{"field": "customfield_10002"}); err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestBoardService_DeleteProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/properties/burndown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Board.DeleteProperty(35, "burndown"); err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestBoardService_GetQuickFilters(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/quickfilter", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"maxResults": "2"})
+		fmt.Fprint(w, `{"maxResults": 2, "startAt": 0, "total": 3, "isLast": false, "values": [
+			{"id": 1, "boardId": 35, "name": "Only my issues", "jql": "assignee = currentUser()", "position": 0},
+			{"id": 2, "boardId": 35, "name": "Bugs", "jql": "issuetype = Bug", "description": "Bugs only", "position": 1}]}`)
+	})
+
+	filters, _, err := testClient.Board.GetQuickFilters(35, &SearchOptions{MaxResults: 2})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if filters == nil {
+		t.Fatal("Expected quick filter list. Got nil.")
+	}
+	if filters.IsLast || len(filters.Values) != 2 || filters.Values[1].JQL != "issuetype = Bug" {
+		t.Errorf("Unexpected quick filters %+v", filters)
+	}
+}
+
+func TestBoardService_GetQuickFilter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/quickfilter/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 2, "boardId": 35, "name": "Bugs", "jql": "issuetype = Bug", "description": "Bugs only", "position": 1}`)
+	})
+
+	filter, _, err := testClient.Board.GetQuickFilter(35, 2)
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if filter == nil || filter.Name != "Bugs" || filter.Position != 1 {
+		t.Errorf("Unexpected quick filter %+v", filter)
+	}
+}
+
+func TestBoardService_CreateBoardWithFilter_NewFilter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/filter/my", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": "10010", "name": "Other board", "jql": "project = OPS"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/filter", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body CreateFilterOptions
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Name != "Payments" || body.Jql != "project = PAY ORDER BY Rank" {
+			t.Errorf("Unexpected filter %+v", body)
+		}
+		fmt.Fprint(w, `{"id": "10020", "name": "Payments", "jql": "project = PAY ORDER BY Rank"}`)
+	})
+	testMux.HandleFunc("/rest/agile/1.0/board", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body Board
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.FilterID != 10020 || body.Location == nil || body.Location.ProjectKeyOrID != "PAY" {
+			t.Errorf("Unexpected board %+v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 84, "self": "http://www.example.com/jira/rest/agile/1.0/board/84", "name": "Payments", "type": "scrum"}`)
+	})
+
+	board, _, err := testClient.Board.CreateBoardWithFilter(&CreateBoardWithFilterOptions{
+		Name:     "Payments",
+		Type:     "scrum",
+		JQL:      "project = PAY ORDER BY Rank",
+		Location: &BoardLocation{Type: "project", ProjectKeyOrID: "PAY"},
+	})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if board == nil || board.ID != 84 || board.FilterID != 10020 {
+		t.Errorf("Unexpected board %+v", board)
+	}
+}
+
+func TestBoardService_CreateBoardWithFilter_ReuseFilter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/filter/my", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "10020", "name": "Payments filter", "jql": "project = PAY"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/filter", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the filter to be reused")
+	})
+	testMux.HandleFunc("/rest/agile/1.0/board", func(w http.ResponseWriter, r *http.Request) {
+		var body Board
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.FilterID != 10020 {
+			t.Errorf("Expected filter 10020. Got %d", body.FilterID)
+		}
+		fmt.Fprint(w, `{"id": 84, "name": "Payments", "type": "kanban"}`)
+	})
+
+	board, _, err := testClient.Board.CreateBoardWithFilter(&CreateBoardWithFilterOptions{Name: "Payments", Type: "kanban", FilterName: "Payments filter", JQL: "project = PAY"})
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if board == nil || board.FilterID != 10020 {
+		t.Errorf("Unexpected board %+v", board)
+	}
+}
+
+func TestBoardService_CreateBoardWithFilter_FilterConflict(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/filter/my", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "10020", "name": "Payments", "jql": "project = OPS"}]`)
+	})
+	testMux.HandleFunc("/rest/agile/1.0/board", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no board to be created")
+	})
+
+	if _, _, err := testClient.Board.CreateBoardWithFilter(&CreateBoardWithFilterOptions{Name: "Payments", Type: "scrum", JQL: "project = PAY"}); err == nil {
+		t.Error("Expected an error for a filter with different JQL")
+	}
+}
+
+func TestBoardService_DeleteBoardWithFilter(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// pages of the boards of the filter, all but the last of a single board
+		pages   []string
+		deleted bool
+	}{
+		{"unshared", []string{`[{"id": 35, "name": "Test board"}]`}, true},
+		{"shared", []string{`[{"id": 35, "name": "Test board"}, {"id": 36, "name": "Team board"}]`}, false},
+		{"shared on a later page", []string{`[{"id": 35, "name": "Test board"}]`, `[{"id": 36, "name": "Team board"}]`}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+			raw, err := ioutil.ReadFile("./mocks/board_configuration.json")
+			if err != nil {
+				t.Error(err.Error())
+			}
+			testMux.HandleFunc("/rest/agile/1.0/board/35/configuration", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, string(raw))
+			})
+			testMux.HandleFunc("/rest/agile/1.0/board/filter/12116", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+				last := startAt == len(tc.pages)-1
+				fmt.Fprintf(w, `{"maxResults": 1, "startAt": %d, "isLast": %t, "values": %s}`, startAt, last, tc.pages[startAt])
+			})
+			boardDeleted := false
+			testMux.HandleFunc("/rest/agile/1.0/board/35", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				boardDeleted = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+			filterDeleted := false
+			testMux.HandleFunc("/rest/api/2/filter/12116", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "DELETE")
+				filterDeleted = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			deletion, _, err := testClient.Board.DeleteBoardWithFilter(35, true)
+			if err != nil {
+				t.Errorf("Got error: %v", err)
+			}
+			if !boardDeleted || filterDeleted != tc.deleted {
+				t.Errorf("Expected board deleted and filter deleted %v. Got %v and %v", tc.deleted, boardDeleted, filterDeleted)
+			}
+			if deletion == nil || deletion.FilterID != 12116 || deletion.FilterDeleted != tc.deleted {
+				t.Errorf("Unexpected deletion %+v", deletion)
+			}
+			if !tc.deleted && (len(deletion.SharedWith) != 1 || deletion.SharedWith[0].ID != 36) {
+				t.Errorf("Expected the filter to be shared with board 36. Got %+v", deletion.SharedWith)
+			}
+		})
+	}
+}
+
+func TestBoardService_DeleteBoardWithFilter_KeepFilter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/board/35/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 35, "filter": {"id": "12116"}}`)
+	})
+	testMux.HandleFunc("/rest/agile/1.0/board/35", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/filter/12116", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the filter to be kept")
+	})
+
+	deletion, _, err := testClient.Board.DeleteBoardWithFilter(35, false)
+	if err != nil {
+		t.Errorf("Got error: %v", err)
+	}
+	if deletion == nil || deletion.FilterDeleted || deletion.FilterID != 12116 {
+		t.Errorf("Unexpected deletion %+v", deletion)
+	}
 }
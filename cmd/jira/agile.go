@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+func boardList(c *cli, args []string) error {
+	fs := c.flags("board list")
+	options := &jira.BoardListOptions{SearchOptions: jira.SearchOptions{MaxResults: 50}}
+	fs.StringVar(&options.BoardType, "type", "", "board type: scrum or kanban")
+	fs.StringVar(&options.Name, "name", "", "part of the board name")
+	fs.StringVar(&options.ProjectKeyOrID, "project", "", "project key or ID")
+	format := outputFlag(fs)
+	if _, err := parse(fs, args, 0, "[-type T] [-name N] [-project P]"); err != nil {
+		return err
+	}
+
+	var boards []jira.Board
+	for {
+		page, _, err := c.client.Board.GetAllBoards(options)
+		if err != nil {
+			return err
+		}
+		boards = append(boards, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		options.StartAt += len(page.Values)
+	}
+
+	t := &table{header: []string{"id", "name", "type", "project"}}
+	for _, b := range boards {
+		var project string
+		if b.Location != nil {
+			project = b.Location.ProjectKey
+		}
+		t.add(strconv.Itoa(b.ID), b.Name, b.Type, project)
+	}
+	return c.write(*format, t, boards)
+}
+
+func sprintList(c *cli, args []string) error {
+	fs := c.flags("sprint list")
+	boardID := fs.Int("board", 0, "board ID (required)")
+	options := &jira.GetAllSprintsOptions{SearchOptions: jira.SearchOptions{MaxResults: 50}}
+	fs.StringVar(&options.State, "state", "", "comma separated states: future, active or closed")
+	format := outputFlag(fs)
+	if _, err := parse(fs, args, 0, "-board ID [-state S]"); err != nil {
+		return err
+	}
+	if *boardID == 0 {
+		return errUsageOf("sprint list -board ID [-state S]")
+	}
+
+	var sprints []jira.Sprint
+	for {
+		page, _, err := c.client.Board.GetAllSprintsWithOptions(*boardID, options)
+		if err != nil {
+			return err
+		}
+		sprints = append(sprints, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		options.StartAt += len(page.Values)
+	}
+
+	t := &table{header: []string{"id", "name", "state", "start", "end", "goal"}}
+	for _, s := range sprints {
+		t.add(strconv.Itoa(s.ID), s.Name, s.State, formatDate(s.StartDate), formatDate(s.EndDate), s.Goal)
+	}
+	return c.write(*format, t, sprints)
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
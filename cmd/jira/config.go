@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	jira "github.com/tya/go-jira"
+)
+
+// config is the config file, e.g.
+//
+//	{
+//	  "default": "cloud",
+//	  "profiles": {
+//	    "cloud": {"url": "https://example.atlassian.net", "auth": "basic",
+//	              "username": "me@example.com", "passwordEnv": "JIRA_API_TOKEN"},
+//	    "server": {"url": "https://jira.example.com", "auth": "cookie", "username": "me"}
+//	  }
+//	}
+//
+// Without a config file, the profile is read from the environment variables
+// JIRA_URL, JIRA_USERNAME and JIRA_PASSWORD, with basic authentication if
+// JIRA_USERNAME is set.
+type config struct {
+	Default  string             `json:"default"`
+	Profiles map[string]profile `json:"profiles"`
+}
+
+// profile is a Jira instance and how to authenticate with it
+type profile struct {
+	URL string `json:"url"`
+	// Auth selects the transport: basic, cookie, jwt or none
+	Auth     string `json:"auth"`
+	Username string `json:"username,omitempty"`
+	// Password is the password or API token, PasswordEnv the environment
+	// variable holding it, to keep it out of the file
+	Password    string `json:"password,omitempty"`
+	PasswordEnv string `json:"passwordEnv,omitempty"`
+	// Issuer and Secret (or SecretEnv) are the JWT credentials of an add-on
+	Issuer    string `json:"issuer,omitempty"`
+	Secret    string `json:"secret,omitempty"`
+	SecretEnv string `json:"secretEnv,omitempty"`
+}
+
+// loadProfile reads the profile name of the config file path. Empty
+// arguments default to $JIRA_CONFIG and $JIRA_PROFILE.
+func loadProfile(path, name string) (*profile, error) {
+	if name == "" {
+		name = os.Getenv("JIRA_PROFILE")
+	}
+	explicit := path != "" || os.Getenv("JIRA_CONFIG") != ""
+	if path == "" {
+		path = os.Getenv("JIRA_CONFIG")
+	}
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(dir, "go-jira", "config.json")
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit && name == "" && os.Getenv("JIRA_URL") != "" {
+		return envProfile(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cfg config
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg.profile(name)
+}
+
+// profile returns the named profile, the default one if name is empty
+func (cfg *config) profile(name string) (*profile, error) {
+	if name == "" {
+		name = cfg.Default
+	}
+	if name == "" && len(cfg.Profiles) == 1 {
+		for n := range cfg.Profiles {
+			name = n
+		}
+	}
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		var names []string
+		for n := range cfg.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("no profile %q, the profiles are %s", name, strings.Join(names, ", "))
+	}
+	if p.URL == "" {
+		return nil, fmt.Errorf("profile %q has no url", name)
+	}
+	return &p, nil
+}
+
+func envProfile() *profile {
+	p := &profile{URL: os.Getenv("JIRA_URL"), Username: os.Getenv("JIRA_USERNAME"), PasswordEnv: "JIRA_PASSWORD"}
+	if p.Username != "" {
+		p.Auth = "basic"
+	}
+	return p
+}
+
+// secret returns value, or if it is empty the environment variable env
+func secret(value, env string) string {
+	if value == "" && env != "" {
+		return os.Getenv(env)
+	}
+	return value
+}
+
+// client returns a client for the Jira instance of the profile, authenticating with its transport
+func (p *profile) client() (*jira.Client, error) {
+	var httpClient *http.Client
+	switch p.Auth {
+	case "", "none":
+		return jira.NewClient(nil, p.URL)
+	case "basic":
+		tp := &jira.BasicAuthTransport{Username: p.Username, Password: secret(p.Password, p.PasswordEnv)}
+		httpClient = tp.Client()
+	case "cookie":
+		tp := &jira.CookieAuthTransport{
+			Username: p.Username,
+			Password: secret(p.Password, p.PasswordEnv),
+			AuthURL:  strings.TrimSuffix(p.URL, "/") + "/rest/auth/1/session",
+		}
+		httpClient = tp.Client()
+	case "jwt":
+		tp := &jira.JWTAuthTransport{Issuer: p.Issuer, Secret: []byte(secret(p.Secret, p.SecretEnv))}
+		httpClient = tp.Client()
+	default:
+		return nil, fmt.Errorf("unknown auth %q, want basic, cookie, jwt or none", p.Auth)
+	}
+	return jira.NewClient(httpClient, p.URL)
+}
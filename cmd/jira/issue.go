@@ -0,0 +1,225 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	jira "github.com/tya/go-jira"
+)
+
+func issueView(c *cli, args []string) error {
+	fs := c.flags("issue view")
+	format := fs.String("o", "text", "output format: text or json")
+	pos, err := parse(fs, args, 1, "KEY")
+	if err != nil {
+		return err
+	}
+	issue, _, err := c.client.Issue.Get(pos[0], nil)
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "json":
+		return c.write(*format, nil, issue)
+	case "text":
+	default:
+		return fmt.Errorf("%w: unknown output format %q, want text or json", errUsage, *format)
+	}
+
+	fmt.Fprintf(c.out, "%s  %s\n\n", issue.Key, fieldValue(issue, "summary"))
+	for _, f := range []string{"issuetype", "status", "priority", "resolution", "assignee", "reporter", "labels", "components", "fixVersions", "created", "updated"} {
+		if v := fieldValue(issue, f); v != "" {
+			fmt.Fprintf(c.out, "%-12s %s\n", f+":", v)
+		}
+	}
+	if d := fieldValue(issue, "description"); d != "" {
+		fmt.Fprintf(c.out, "\n%s\n", d)
+	}
+	if issue.Fields != nil && issue.Fields.Comments != nil {
+		for _, comment := range issue.Fields.Comments.Comments {
+			fmt.Fprintf(c.out, "\n-- %s, %s\n%s\n", comment.Author.DisplayName, comment.Created, comment.Body)
+		}
+	}
+	return nil
+}
+
+func issueCreate(c *cli, args []string) error {
+	fs := c.flags("issue create")
+	project := fs.String("project", "", "project key (required)")
+	issueType := fs.String("type", "Task", "issue type")
+	summary := fs.String("summary", "", "summary (required)")
+	description := fs.String("description", "", "description")
+	assignee := fs.String("assignee", "", "username of the assignee")
+	labels := fs.String("labels", "", "comma separated labels")
+	if _, err := parse(fs, args, 0, "-project P -summary S [-type T]"); err != nil {
+		return err
+	}
+	if *project == "" || *summary == "" {
+		return errUsageOf("issue create -project P -summary S [-type T]")
+	}
+
+	fields := &jira.IssueFields{
+		Project:     jira.Project{Key: *project},
+		Type:        jira.IssueType{Name: *issueType},
+		Summary:     *summary,
+		Description: *description,
+		Labels:      split(*labels),
+	}
+	if *assignee != "" {
+		fields.Assignee = &jira.User{Name: *assignee}
+	}
+	issue, _, err := c.client.Issue.Create(&jira.Issue{Fields: fields})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.out, issue.Key)
+	return nil
+}
+
+func issueEdit(c *cli, args []string) error {
+	fs := c.flags("issue edit")
+	summary := fs.String("summary", "", "new summary")
+	description := fs.String("description", "", "new description")
+	labels := fs.String("labels", "", "comma separated labels, replacing the current ones")
+	pos, err := parse(fs, args, 1, "KEY [-summary S] [-description D] [-labels a,b]")
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]interface{}{}
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "summary":
+			fields["summary"] = *summary
+		case "description":
+			fields["description"] = *description
+		case "labels":
+			fields["labels"] = split(*labels)
+		}
+	})
+	if len(fields) == 0 {
+		return errUsageOf("issue edit KEY [-summary S] [-description D] [-labels a,b]")
+	}
+	_, err = c.client.Issue.UpdateIssue(pos[0], map[string]interface{}{"fields": fields})
+	return err
+}
+
+// fieldValues is the value of the repeatable -field id=value flag
+type fieldValues []jira.TransitionOption
+
+func (f *fieldValues) String() string {
+	return ""
+}
+
+func (f *fieldValues) Set(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 {
+		return errors.New("want id=value")
+	}
+	*f = append(*f, jira.TransitionWithField(s[:i], s[i+1:]))
+	return nil
+}
+
+func issueTransition(c *cli, args []string) error {
+	fs := c.flags("issue transition")
+	var options fieldValues
+	fs.Var(&options, "field", "id=value of a field of the transition screen, e.g. resolution=Fixed; repeatable")
+	comment := fs.String("comment", "", "comment added with the transition")
+	pos, err := parse(fs, args, 2, "KEY NAME [-field id=value]... [-comment C]")
+	if err != nil {
+		return err
+	}
+	if *comment != "" {
+		options = append(options, jira.TransitionWithComment(*comment))
+	}
+	_, _, err = c.client.Issue.TransitionTo(pos[0], pos[1], options...)
+	return err
+}
+
+func issueComment(c *cli, args []string) error {
+	fs := c.flags("issue comment")
+	pos, err := parse(fs, args, 2, "KEY TEXT")
+	if err != nil {
+		return err
+	}
+	_, _, err = c.client.Issue.AddComment(pos[0], &jira.Comment{Body: pos[1]})
+	return err
+}
+
+func issueAssign(c *cli, args []string) error {
+	fs := c.flags("issue assign")
+	accountID := fs.Bool("account-id", false, "USER is an account ID, as on Jira Cloud")
+	pos, err := parse(fs, args, 2, "KEY USER")
+	if err != nil {
+		return err
+	}
+	key, user := pos[0], pos[1]
+
+	if user == "-" {
+		_, err = c.client.Issue.UpdateIssue(key, map[string]interface{}{"fields": map[string]interface{}{"assignee": nil}})
+		return err
+	}
+	assignee := &jira.User{Name: user}
+	if *accountID {
+		assignee = &jira.User{AccountID: user}
+	}
+	_, err = c.client.Issue.UpdateAssignee(key, assignee)
+	return err
+}
+
+func search(c *cli, args []string) error {
+	fs := c.flags("search")
+	fields := fs.String("fields", "key,summary,status,assignee", "comma separated fields to show, e.g. issuetype or customfield_10002")
+	max := fs.Int("max", 0, "maximum number of issues, 0 for all")
+	format := outputFlag(fs)
+	pos, err := parse(fs, args, 1, "[-fields f1,f2] [-max N] JQL")
+	if err != nil {
+		return err
+	}
+
+	t := &table{header: split(*fields)}
+	options := &jira.SearchOptions{MaxResults: 50}
+	for _, f := range t.header {
+		if f != "key" && f != "id" {
+			options.Fields = append(options.Fields, f)
+		}
+	}
+	if *max > 0 && *max < options.MaxResults {
+		options.MaxResults = *max
+	}
+
+	var issues []jira.Issue
+	errEnough := errors.New("enough issues")
+	err = c.client.Issue.SearchPages(pos[0], options, func(issue jira.Issue) error {
+		if *max > 0 && len(issues) == *max {
+			return errEnough
+		}
+		issues = append(issues, issue)
+		return nil
+	})
+	if err != nil && err != errEnough {
+		return err
+	}
+
+	for i := range issues {
+		row := make([]string, len(t.header))
+		for j, f := range t.header {
+			row[j] = fieldValue(&issues[i], f)
+		}
+		t.add(row...)
+	}
+	return c.write(*format, t, issues)
+}
+
+// split splits a comma separated list, dropping empty entries
+func split(list string) []string {
+	var values []string
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
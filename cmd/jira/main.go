@@ -0,0 +1,185 @@
+// Command jira is a command-line client for Jira built on go-jira.
+//
+// Usage:
+//
+//	jira [-config file] [-profile name] <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	issue view KEY                       show an issue
+//	issue create -project P -type T -summary S
+//	                                     create an issue and print its key
+//	issue edit KEY [-summary S] [-description D] [-labels a,b]
+//	issue transition KEY NAME [-field id=value]... [-comment C]
+//	                                     move an issue by transition or status name
+//	issue comment KEY TEXT               add a comment
+//	issue assign KEY USER                assign an issue, "-" to unassign
+//	search [-fields f1,f2] [-max N] JQL  search issues
+//	board list [-type T] [-name N] [-project P]
+//	sprint list -board ID [-state S]
+//	cycle list -project ID [-version ID] Zephyr test cycles
+//	execution list [-cycle ID] [-issue ID]
+//	                                     Zephyr test executions
+//	execution run ID STATUS [-comment C] set the status of an execution
+//
+// The listing commands take -o table, csv or json. The URL and the
+// authentication of a Jira instance are read from a profile of the config
+// file, see config.go.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	jira "github.com/tya/go-jira"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// cli runs a single command
+type cli struct {
+	out, errOut io.Writer
+	client      *jira.Client
+}
+
+// command runs a command with its arguments, the flags included
+type command func(c *cli, args []string) error
+
+var commands = map[string]map[string]command{
+	"issue": {
+		"view":       issueView,
+		"create":     issueCreate,
+		"edit":       issueEdit,
+		"transition": issueTransition,
+		"comment":    issueComment,
+		"assign":     issueAssign,
+	},
+	"search":    {"": search},
+	"board":     {"list": boardList},
+	"sprint":    {"list": sprintList},
+	"cycle":     {"list": cycleList},
+	"execution": {"list": executionList, "run": executionRun},
+}
+
+// errUsage reports a command line that cannot be run
+var errUsage = errors.New("usage")
+
+// errUsageOf returns the usage error of a command, synopsis being e.g. "issue view KEY"
+func errUsageOf(synopsis string) error {
+	return fmt.Errorf("%w: jira %s", errUsage, synopsis)
+}
+
+// run runs the command line args and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("jira", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configPath := fs.String("config", "", "config file, defaults to $JIRA_CONFIG or the go-jira/config.json of the user config directory")
+	profileName := fs.String("profile", "", "profile of the config file, defaults to $JIRA_PROFILE or the default profile")
+	fs.Usage = func() { usage(fs) }
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	cmd, args := lookup(fs.Args())
+	if cmd == nil {
+		usage(fs)
+		return 2
+	}
+
+	p, err := loadProfile(*configPath, *profileName)
+	if err != nil {
+		fmt.Fprintf(stderr, "jira: %v\n", err)
+		return 1
+	}
+	client, err := p.client()
+	if err != nil {
+		fmt.Fprintf(stderr, "jira: %v\n", err)
+		return 1
+	}
+
+	err = cmd(&cli{out: stdout, errOut: stderr, client: client}, args)
+	switch {
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(stderr, "jira: %v\n", err)
+		return 2
+	case errors.Is(err, flag.ErrHelp):
+		return 2
+	case err != nil:
+		fmt.Fprintf(stderr, "jira: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// lookup returns the command named by the first or first two args, and the remaining args
+func lookup(args []string) (command, []string) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	group, ok := commands[args[0]]
+	if !ok {
+		return nil, nil
+	}
+	if cmd, ok := group[""]; ok {
+		return cmd, args[1:]
+	}
+	if len(args) < 2 {
+		return nil, nil
+	}
+	return group[args[1]], args[2:]
+}
+
+func usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintln(w, "usage: jira [-config file] [-profile name] <command> [flags] [arguments]")
+	fmt.Fprintln(w, "\ncommands:")
+	var names []string
+	for group, subs := range commands {
+		for sub := range subs {
+			names = append(names, strings.TrimSpace(group+" "+sub))
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Fprintf(w, "  %s\n", n)
+	}
+	fmt.Fprintln(w, "\nflags:")
+	fs.PrintDefaults()
+}
+
+// flags returns the flag set of a command
+func (c *cli) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.errOut)
+	return fs
+}
+
+// parse parses the flags of a command, which may follow its arguments,
+// and checks that it got n arguments
+func parse(fs *flag.FlagSet, args []string, n int, names string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: %v", errUsage, err)
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(positional) != n {
+		return nil, errUsageOf(fs.Name() + " " + names)
+	}
+	return positional, nil
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newJiraServer fakes the endpoints used by the tests and writes a config
+// file with a profile for it, whose path is returned
+func newJiraServer(t *testing.T, mux *http.ServeMux) (string, func()) {
+	server := httptest.NewServer(mux)
+	path := filepath.Join(t.TempDir(), "config.json")
+	cfg := fmt.Sprintf(`{"default": "test", "profiles": {
+		"test": {"url": %q, "auth": "none"},
+		"other": {"url": "https://jira.example.com", "auth": "basic", "username": "me", "passwordEnv": "JIRA_TEST_PASSWORD"}}}`, server.URL)
+	if err := ioutil.WriteFile(path, []byte(cfg), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, server.Close
+}
+
+func runJira(args ...string) (code int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	code = run(args, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func searchMux(t *testing.T) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("fields"); got != "summary,status,customfield_10002" {
+			t.Errorf("Expected the fields summary,status,customfield_10002, got %q", got)
+		}
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 2, "issues": [
+			{"key": "PAY-1", "fields": {"summary": "Refunds, partial", "status": {"name": "To Do"}, "customfield_10002": 3}},
+			{"key": "PAY-2", "fields": {"summary": "Chargebacks", "status": {"name": "Done"}, "customfield_10002": null}}]}`)
+	})
+	return mux
+}
+
+func TestSearch(t *testing.T) {
+	config, teardown := newJiraServer(t, searchMux(t))
+	defer teardown()
+
+	for _, tc := range []struct {
+		format, want string
+	}{
+		{"csv", "key,summary,status,customfield_10002\nPAY-1,\"Refunds, partial\",To Do,3\nPAY-2,Chargebacks,Done,\n"},
+		{"table", "KEY    SUMMARY           STATUS  CUSTOMFIELD_10002\nPAY-1  Refunds, partial  To Do   3\nPAY-2  Chargebacks       Done    \n"},
+	} {
+		code, out, errOut := runJira("-config", config, "search", "-fields", "key,summary,status,customfield_10002", "-o", tc.format, "project = PAY")
+		if code != 0 {
+			t.Fatalf("Exit code %d: %s", code, errOut)
+		}
+		if out != tc.want {
+			t.Errorf("Unexpected %s output:\n%s\nwant:\n%s", tc.format, out, tc.want)
+		}
+	}
+
+	code, out, _ := runJira("-config", config, "search", "-fields", "key,summary,status,customfield_10002", "-o", "json", "-max", "1", "project = PAY")
+	var issues []map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &issues); code != 0 || err != nil {
+		t.Fatalf("Exit code %d, %v: %s", code, err, out)
+	}
+	if len(issues) != 1 || issues[0]["key"] != "PAY-1" {
+		t.Errorf("Expected PAY-1 only, got %v", issues)
+	}
+}
+
+func TestIssueTransition(t *testing.T) {
+	mux := http.NewServeMux()
+	var payload map[string]interface{}
+	mux.HandleFunc("/rest/api/2/issue/PAY-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			json.NewDecoder(r.Body).Decode(&payload)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"transitions": [
+			{"id": "11", "name": "Start", "to": {"name": "In Progress"}, "fields": {}},
+			{"id": "31", "name": "Resolve", "to": {"name": "Done"}, "fields": {
+				"resolution": {"required": true, "name": "Resolution", "schema": {"type": "resolution"},
+					"allowedValues": [{"id": "1", "name": "Fixed"}, {"id": "2", "name": "Won't Fix"}]}}}]}`)
+	})
+	mux.HandleFunc("/rest/api/2/issue/PAY-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key": "PAY-1", "fields": {"resolution": null}}`)
+	})
+	config, teardown := newJiraServer(t, mux)
+	defer teardown()
+
+	code, _, errOut := runJira("-config", config, "issue", "transition", "PAY-1", "done")
+	if code != 1 || !strings.Contains(errOut, `requires the fields Resolution (resolution) of "Fixed", "Won't Fix"`) {
+		t.Errorf("Expected the resolution to be required, got %d: %s", code, errOut)
+	}
+	if payload != nil {
+		t.Errorf("Expected no transition, got %v", payload)
+	}
+
+	if code, _, errOut := runJira("-config", config, "issue", "transition", "PAY-1", "done", "-field", "resolution=fixed", "-comment", "Shipped"); code != 0 {
+		t.Fatalf("Exit code %d: %s", code, errOut)
+	}
+	got, _ := json.Marshal(payload)
+	want := `{"fields":{"resolution":{"id":"1"}},"transition":{"id":"31"},"update":{"comment":[{"add":{"body":"Shipped"}}]}}`
+	if string(got) != want {
+		t.Errorf("Unexpected transition %s, want %s", got, want)
+	}
+
+	code, _, errOut = runJira("-config", config, "issue", "transition", "PAY-1", "Closed")
+	if code != 1 || !strings.Contains(errOut, `PAY-1 has no transition "Closed", the transitions are "Start" to "In Progress", "Resolve" to "Done"`) {
+		t.Errorf("Expected an unknown transition, got %d: %s", code, errOut)
+	}
+	if code, _, errOut := runJira("-config", config, "issue", "transition", "PAY-1", "done", "-field", "resolution"); code != 2 {
+		t.Errorf("Expected a usage error for a field without a value, got %d: %s", code, errOut)
+	}
+}
+
+func TestRun_usage(t *testing.T) {
+	config, teardown := newJiraServer(t, http.NewServeMux())
+	defer teardown()
+
+	for _, args := range [][]string{
+		{"-config", config},
+		{"-config", config, "issue", "delete", "PAY-1"},
+		{"-config", config, "issue", "view"},
+		{"-config", config, "search", "-o", "xml", "project = PAY"},
+	} {
+		if code, _, errOut := runJira(args...); code != 2 || !strings.Contains(errOut, "usage") {
+			t.Errorf("%v: expected the usage, got %d: %s", args, code, errOut)
+		}
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	config, teardown := newJiraServer(t, http.NewServeMux())
+	defer teardown()
+
+	p, err := loadProfile(config, "other")
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if p.URL != "https://jira.example.com" || p.Auth != "basic" || p.PasswordEnv != "JIRA_TEST_PASSWORD" {
+		t.Errorf("Unexpected profile %+v", p)
+	}
+	if _, err := p.client(); err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+
+	if _, err := loadProfile(config, "missing"); err == nil || err.Error() != `no profile "missing", the profiles are other, test` {
+		t.Errorf("Expected the profile to be missing, got %v", err)
+	}
+	p.Auth = "oauth"
+	if _, err := p.client(); err == nil {
+		t.Error("Expected an unknown auth to be an error")
+	}
+}
+
+func TestExecutionList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/zapi/latest/execution", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprint(w, `{"executions": [{"id": 1, "issueKey": "PAY-1", "executionStatus": "1"}], "recordsCount": 2}`)
+			return
+		}
+		fmt.Fprint(w, `{"executions": [{"id": 2, "issueKey": "PAY-2", "executionStatus": "2"}], "recordsCount": 2}`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/util/testExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1, "name": "PASS"}, {"id": 2, "name": "FAIL"}]`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/util/teststepExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	config, teardown := newJiraServer(t, mux)
+	defer teardown()
+
+	code, out, errOut := runJira("-config", config, "execution", "list", "-cycle", "100", "-o", "csv")
+	if code != 0 {
+		t.Fatalf("Exit code %d: %s", code, errOut)
+	}
+	want := "id,issue,summary,cycle,status,executed by,executed on\n1,PAY-1,,,PASS,,\n2,PAY-2,,,FAIL,,\n"
+	if out != want {
+		t.Errorf("Unexpected output:\n%s\nwant:\n%s", out, want)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+// table is the output of a listing command
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+// outputFormat is the value of the -o flag of the listing commands
+type outputFormat string
+
+func (f *outputFormat) String() string {
+	return string(*f)
+}
+
+func (f *outputFormat) Set(s string) error {
+	switch s {
+	case "table", "csv", "json":
+		*f = outputFormat(s)
+		return nil
+	}
+	return errors.New("want table, csv or json")
+}
+
+// outputFlag adds the -o flag of the listing commands to fs
+func outputFlag(fs *flag.FlagSet) *string {
+	format := outputFormat("table")
+	fs.Var(&format, "o", "output format: table, csv or json")
+	return (*string)(&format)
+}
+
+// write writes t in format; json writes raw, the values the table was made of
+func (c *cli) write(format string, t *table, raw interface{}) error {
+	switch format {
+	case "table":
+		w := tabwriter.NewWriter(c.out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, strings.ToUpper(strings.Join(t.header, "\t")))
+		for _, row := range t.rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	case "csv":
+		w := csv.NewWriter(c.out)
+		w.Write(t.header)
+		w.WriteAll(t.rows)
+		return w.Error()
+	case "json":
+		enc := json.NewEncoder(c.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(raw)
+	}
+	return fmt.Errorf("%w: unknown output format %q, want table, csv or json", errUsage, format)
+}
+
+// fieldValue returns the value of the field of issue as text. Fields are
+// named by their ID, e.g. "status" or "customfield_10002".
+func fieldValue(issue *jira.Issue, field string) string {
+	if field == "key" {
+		return issue.Key
+	}
+	if field == "id" {
+		return issue.ID
+	}
+	f := issue.Fields
+	if f == nil {
+		return ""
+	}
+	switch field {
+	case "summary":
+		return f.Summary
+	case "description":
+		return f.Description
+	case "issuetype":
+		return f.Type.Name
+	case "status":
+		if f.Status != nil {
+			return f.Status.Name
+		}
+	case "priority":
+		if f.Priority != nil {
+			return f.Priority.Name
+		}
+	case "resolution":
+		if f.Resolution != nil {
+			return f.Resolution.Name
+		}
+	case "assignee":
+		return userName(f.Assignee)
+	case "reporter":
+		return userName(f.Reporter)
+	case "created":
+		return formatTime(time.Time(f.Created))
+	case "updated":
+		return formatTime(time.Time(f.Updated))
+	case "duedate":
+		if t := time.Time(f.Duedate); !t.IsZero() {
+			return t.Format("2006-01-02")
+		}
+	case "labels":
+		return strings.Join(f.Labels, ",")
+	case "components":
+		var names []string
+		for _, c := range f.Components {
+			names = append(names, c.Name)
+		}
+		return strings.Join(names, ",")
+	case "fixVersions":
+		var names []string
+		for _, v := range f.FixVersions {
+			names = append(names, v.Name)
+		}
+		return strings.Join(names, ",")
+	default:
+		if v, ok := f.Unknowns[field]; ok {
+			return formatValue(v)
+		}
+	}
+	return ""
+}
+
+func userName(u *jira.User) string {
+	if u == nil {
+		return ""
+	}
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.Name
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// formatValue returns the value of a custom field as text, e.g. the value of an option
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case map[string]interface{}:
+		for _, key := range []string{"value", "name", "displayName", "key"} {
+			if s, ok := v[key].(string); ok {
+				return s
+			}
+		}
+	case []interface{}:
+		var values []string
+		for _, e := range v {
+			values = append(values, formatValue(e))
+		}
+		return strings.Join(values, ",")
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
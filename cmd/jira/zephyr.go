@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	jira "github.com/tya/go-jira"
+)
+
+func cycleList(c *cli, args []string) error {
+	fs := c.flags("cycle list")
+	options := &jira.CycleListOptions{}
+	fs.IntVar(&options.ProjectID, "project", 0, "project ID (required)")
+	fs.IntVar(&options.VersionID, "version", -1, "version ID, -1 for unscheduled")
+	format := outputFlag(fs)
+	if _, err := parse(fs, args, 0, "-project ID [-version ID]"); err != nil {
+		return err
+	}
+	if options.ProjectID == 0 {
+		return errUsageOf("cycle list -project ID [-version ID]")
+	}
+
+	cycles, _, err := c.client.Cycle.GetList(options)
+	if err != nil {
+		return err
+	}
+	// The cycles are keyed by ID in the reply, so they come in random order
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i].ID < cycles[j].ID })
+
+	t := &table{header: []string{"id", "name", "version", "build", "environment", "executions", "executed"}}
+	for _, cy := range cycles {
+		t.add(strconv.Itoa(cy.ID), cy.Name, cy.VersionName, cy.Build, cy.Environment,
+			strconv.Itoa(cy.TotalExecutions), strconv.Itoa(cy.TotalExecuted))
+	}
+	return c.write(*format, t, cycles)
+}
+
+func executionList(c *cli, args []string) error {
+	fs := c.flags("execution list")
+	options := &jira.ExecutionListOptions{}
+	fs.IntVar(&options.CycleID, "cycle", 0, "cycle ID")
+	fs.IntVar(&options.IssueID, "issue", 0, "test issue ID")
+	fs.IntVar(&options.ProjectID, "project", 0, "project ID")
+	fs.IntVar(&options.VersionID, "version", 0, "version ID")
+	format := outputFlag(fs)
+	if _, err := parse(fs, args, 0, "[-cycle ID] [-issue ID] [-project ID] [-version ID]"); err != nil {
+		return err
+	}
+
+	executions, _, err := c.client.Execution.GetAll(options)
+	if err != nil {
+		return err
+	}
+	catalogue, _, err := c.client.Execution.GetStatusCatalogue()
+	if err != nil {
+		return err
+	}
+
+	t := &table{header: []string{"id", "issue", "summary", "cycle", "status", "executed by", "executed on"}}
+	for _, e := range executions {
+		status := e.ExecutionStatus
+		if ts, err := catalogue.ExecutionStatus(status); err == nil {
+			status = ts.Name
+		}
+		t.add(strconv.Itoa(e.ID), e.IssueKey, e.Summary, e.CycleName, status, e.ExecutedByDisplay, e.ExecutedOn)
+	}
+	return c.write(*format, t, executions)
+}
+
+func executionRun(c *cli, args []string) error {
+	fs := c.flags("execution run")
+	status := &jira.ExecutionStatus{}
+	fs.StringVar(&status.Comment, "comment", "", "comment of the execution")
+	fs.StringVar(&status.Assignee, "assignee", "", "username the execution is assigned to")
+	pos, err := parse(fs, args, 2, "ID STATUS [-comment C]")
+	if err != nil {
+		return err
+	}
+	id, err := strconv.Atoi(pos[0])
+	if err != nil {
+		return fmt.Errorf("%w: execution ID %q is not a number", errUsage, pos[0])
+	}
+	execution, _, err := c.client.Execution.ExecuteByName(id, pos[1], status)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "%d %s\n", execution.ID, execution.ExecutionStatus)
+	return nil
+}
@@ -1,6 +1,10 @@
 package jira
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
 
 // ComponentService handles components for the Jira instance / API.//
 // Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component
@@ -20,6 +24,44 @@ type CreateComponentOptions struct {
 	ProjectID    int    `json:"projectId,omitempty" structs:"projectId,omitempty"`
 }
 
+// UpdateComponentOptions are passed to the ComponentService.Update function.
+// Only the fields that are set are changed.
+type UpdateComponentOptions struct {
+	Name         string `json:"name,omitempty" structs:"name,omitempty"`
+	Description  string `json:"description,omitempty" structs:"description,omitempty"`
+	Lead         *User  `json:"lead,omitempty" structs:"lead,omitempty"`
+	LeadUserName string `json:"leadUserName,omitempty" structs:"leadUserName,omitempty"`
+	AssigneeType string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	Assignee     *User  `json:"assignee,omitempty" structs:"assignee,omitempty"`
+}
+
+// ComponentListOptions specifies the optional parameters to the ComponentService.GetList
+type ComponentListOptions struct {
+	// Query filters results to components whose name or description contains the query
+	Query string `url:"query,omitempty"`
+	// OrderBy orders the results by a field, e.g. "name" or "-lead"
+	OrderBy string `url:"orderBy,omitempty"`
+	// StartAt is the index of the first item returned, base index 0
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of items returned per page, default 50
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// ComponentsList reflects a page of the components of a project
+type ComponentsList struct {
+	MaxResults int                `json:"maxResults" structs:"maxResults"`
+	StartAt    int                `json:"startAt" structs:"startAt"`
+	Total      int                `json:"total" structs:"total"`
+	IsLast     bool               `json:"isLast" structs:"isLast"`
+	Values     []ProjectComponent `json:"values" structs:"values"`
+}
+
+// ComponentCount holds the number of issues of a component
+type ComponentCount struct {
+	Self       string `json:"self" structs:"self"`
+	IssueCount int    `json:"issueCount" structs:"issueCount"`
+}
+
 // CreateWithContext creates a new Jira component based on the given options.
 func (s *ComponentService) CreateWithContext(ctx context.Context, options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
 	apiEndpoint := "rest/api/2/component"
@@ -42,3 +84,132 @@ func (s *ComponentService) CreateWithContext(ctx context.Context, options *Creat
 func (s *ComponentService) Create(options *CreateComponentOptions) (*ProjectComponent, *Response, error) {
 	return s.CreateWithContext(context.Background(), options)
 }
+
+// GetWithContext returns the component identified by componentID
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-getComponent
+func (s *ComponentService) GetWithContext(ctx context.Context, componentID string) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *ComponentService) Get(componentID string) (*ProjectComponent, *Response, error) {
+	return s.GetWithContext(context.Background(), componentID)
+}
+
+// UpdateWithContext updates the component identified by componentID
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-updateComponent
+func (s *ComponentService) UpdateWithContext(ctx context.Context, componentID string, options *UpdateComponentOptions) (*ProjectComponent, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	component := new(ProjectComponent)
+	resp, err := s.client.Do(req, component)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return component, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *ComponentService) Update(componentID string, options *UpdateComponentOptions) (*ProjectComponent, *Response, error) {
+	return s.UpdateWithContext(context.Background(), componentID, options)
+}
+
+// DeleteWithContext deletes the component identified by componentID.
+// If moveIssuesTo is not empty, the issues of the component are moved to the
+// component with that ID, otherwise the component is just removed from them.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-delete
+func (s *ComponentService) DeleteWithContext(ctx context.Context, componentID, moveIssuesTo string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s", componentID)
+	if moveIssuesTo != "" {
+		apiEndpoint += "?moveIssuesTo=" + url.QueryEscape(moveIssuesTo)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *ComponentService) Delete(componentID, moveIssuesTo string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), componentID, moveIssuesTo)
+}
+
+// GetListWithContext returns a page of the components of the project
+// identified by projectID (id or key)
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/project-getProjectComponentsPaginated
+func (s *ComponentService) GetListWithContext(ctx context.Context, projectID string, options *ComponentListOptions) (*ComponentsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/component", projectID)
+	u, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	components := new(ComponentsList)
+	resp, err := s.client.Do(req, components)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return components, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *ComponentService) GetList(projectID string, options *ComponentListOptions) (*ComponentsList, *Response, error) {
+	return s.GetListWithContext(context.Background(), projectID, options)
+}
+
+// GetRelatedIssueCountWithContext returns the number of issues of the component identified by componentID
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.10.1/#api/2/component-getComponentRelatedIssues
+func (s *ComponentService) GetRelatedIssueCountWithContext(ctx context.Context, componentID string) (*ComponentCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/component/%s/relatedIssueCounts", componentID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(ComponentCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return count, resp, nil
+}
+
+// GetRelatedIssueCount wraps GetRelatedIssueCountWithContext using the background context.
+func (s *ComponentService) GetRelatedIssueCount(componentID string) (*ComponentCount, *Response, error) {
+	return s.GetRelatedIssueCountWithContext(context.Background(), componentID)
+}
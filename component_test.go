@@ -1,7 +1,9 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 )
@@ -27,3 +29,137 @@ func TestComponentService_Create_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestComponentService_Get_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/api/2/component/10000")
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/component/10000", "id": "10000", "name": "billing", "project": "PAY", "projectId": 10042}`)
+	})
+
+	component, _, err := testClient.Component.Get("10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if component == nil || component.Name != "billing" {
+		t.Errorf("Expected component billing, got %+v", component)
+	}
+}
+
+func TestComponentService_Get_NoComponent(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/99999", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["The component with id 99999 does not exist."], "errors": {}}`)
+	})
+
+	component, _, err := testClient.Component.Get("99999")
+	if component != nil {
+		t.Errorf("Expected nil. Got %+v", component)
+	}
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestComponentService_Update_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, "/rest/api/2/component/10000")
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 2 || body["leadUserName"] != "fred" || body["assigneeType"] != "COMPONENT_LEAD" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		fmt.Fprint(w, `{"id": "10000", "name": "billing", "assigneeType": "COMPONENT_LEAD"}`)
+	})
+
+	component, _, err := testClient.Component.Update("10000", &UpdateComponentOptions{LeadUserName: "fred", AssigneeType: "COMPONENT_LEAD"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if component == nil || component.AssigneeType != "COMPONENT_LEAD" {
+		t.Errorf("Expected updated component, got %+v", component)
+	}
+}
+
+func TestComponentService_Delete_MoveIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/component/10000?moveIssuesTo=10001")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete("10000", "10001"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestComponentService_Delete_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestParams(t, r, map[string]string{})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Component.Delete("10000", ""); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestComponentService_GetList_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/project_components.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc("/rest/api/2/project/PAY/component", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"maxResults": "2", "query": "bill"})
+		fmt.Fprint(w, string(raw))
+	})
+
+	opts := &ComponentListOptions{Query: "bill", MaxResults: 2}
+	components, _, err := testClient.Component.GetList("PAY", opts)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if components == nil {
+		t.Fatal("Expected component list. Component list is nil")
+	}
+	if components.Total != 3 || components.IsLast || len(components.Values) != 2 {
+		t.Errorf("Unexpected page %+v", components)
+	}
+	if components.Values[0].IssueCount != 23 {
+		t.Errorf("Expected 23 issues but got %d", components.Values[0].IssueCount)
+	}
+}
+
+func TestComponentService_GetRelatedIssueCount_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/component/10000/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/component/10000", "issueCount": 23}`)
+	})
+
+	count, _, err := testClient.Component.GetRelatedIssueCount("10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if count == nil || count.IssueCount != 23 {
+		t.Errorf("Expected 23 issues, got %+v", count)
+	}
+}
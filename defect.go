@@ -0,0 +1,253 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+const (
+	DefectUpdateError = "Defect Update Error"
+	DefectListError   = "Defect List Error"
+)
+
+// Defect is an issue linked to a Zephyr execution or step result
+type Defect struct {
+	Key        string `json:"key"`
+	Summary    string `json:"summary,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Resolution string `json:"resolution,omitempty"`
+}
+
+// CycleDefect is a defect linked to one or more executions of a cycle
+type CycleDefect struct {
+	Defect
+	// ExecutionIDs are the executions the defect is linked to
+	ExecutionIDs []int
+	// IssueKeys are the test issues of those executions
+	IssueKeys []string
+}
+
+// defectUpdate replaces the defect list of an execution or step result.
+// The ZAPI requires the current status to be sent along.
+type defectUpdate struct {
+	Status           string   `json:"status,omitempty"`
+	DefectList       []string `json:"defectList"`
+	UpdateDefectList string   `json:"updateDefectList"`
+}
+
+// defectKeys returns the keys of defects with add merged in and remove left out
+func defectKeys(defects []Defect, add, remove []string) []string {
+	drop := make(map[string]bool, len(remove))
+	for _, k := range remove {
+		drop[k] = true
+	}
+	keys := []string{}
+	seen := make(map[string]bool)
+	for _, d := range defects {
+		if !drop[d.Key] && !seen[d.Key] {
+			seen[d.Key] = true
+			keys = append(keys, d.Key)
+		}
+	}
+	for _, k := range add {
+		if !drop[k] && !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// SetDefectsWithContext replaces the defects linked to an execution, keeping its status
+func (s *ExecutionService) SetDefectsWithContext(ctx context.Context, exeID int, keys []string) (*Execution, *Response, error) {
+	exe, resp, err := s.GetWithContext(ctx, exeID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.setDefects(ctx, exe, keys)
+}
+
+// SetDefects wraps SetDefectsWithContext using the background context
+func (s *ExecutionService) SetDefects(exeID int, keys []string) (*Execution, *Response, error) {
+	return s.SetDefectsWithContext(context.Background(), exeID, keys)
+}
+
+// AddDefectsWithContext links the issues identified by keys to an execution
+func (s *ExecutionService) AddDefectsWithContext(ctx context.Context, exeID int, keys ...string) (*Execution, *Response, error) {
+	exe, resp, err := s.GetWithContext(ctx, exeID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.setDefects(ctx, exe, defectKeys(exe.Defects, keys, nil))
+}
+
+// AddDefects wraps AddDefectsWithContext using the background context
+func (s *ExecutionService) AddDefects(exeID int, keys ...string) (*Execution, *Response, error) {
+	return s.AddDefectsWithContext(context.Background(), exeID, keys...)
+}
+
+// RemoveDefectsWithContext unlinks the issues identified by keys from an execution
+func (s *ExecutionService) RemoveDefectsWithContext(ctx context.Context, exeID int, keys ...string) (*Execution, *Response, error) {
+	exe, resp, err := s.GetWithContext(ctx, exeID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.setDefects(ctx, exe, defectKeys(exe.Defects, nil, keys))
+}
+
+// RemoveDefects wraps RemoveDefectsWithContext using the background context
+func (s *ExecutionService) RemoveDefects(exeID int, keys ...string) (*Execution, *Response, error) {
+	return s.RemoveDefectsWithContext(context.Background(), exeID, keys...)
+}
+
+func (s *ExecutionService) setDefects(ctx context.Context, exe *Execution, keys []string) (*Execution, *Response, error) {
+	if keys == nil {
+		keys = []string{}
+	}
+	body := &defectUpdate{
+		Status:           exe.ExecutionStatus,
+		DefectList:       keys,
+		UpdateDefectList: "true",
+	}
+
+	endpoint := fmt.Sprintf(executeEndpointFormat, exe.ID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, endpoint, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", DefectUpdateError, err)
+	}
+
+	reply := new(Execution)
+	resp, err := s.client.Do(req, reply)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", DefectUpdateError, err)
+	}
+	return reply, resp, nil
+}
+
+// CreateDefectWithContext creates issue (typically a bug) and links it to an execution.
+// If linking fails the created issue is returned together with the error,
+// so that the caller can link or clean it up.
+func (s *ExecutionService) CreateDefectWithContext(ctx context.Context, exeID int, issue *Issue) (*Issue, *Response, error) {
+	created, resp, err := s.client.Issue.CreateWithContext(ctx, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+	_, resp, err = s.AddDefectsWithContext(ctx, exeID, created.Key)
+	return created, resp, err
+}
+
+// CreateDefect wraps CreateDefectWithContext using the background context
+func (s *ExecutionService) CreateDefect(exeID int, issue *Issue) (*Issue, *Response, error) {
+	return s.CreateDefectWithContext(context.Background(), exeID, issue)
+}
+
+// AddDefectsWithContext links the issues identified by keys to a step result
+func (s *StepResultService) AddDefectsWithContext(ctx context.Context, stepResultID int, keys ...string) (*StepResult, *Response, error) {
+	result, resp, err := s.GetWithContext(ctx, stepResultID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.setDefects(ctx, result, defectKeys(result.Defects, keys, nil))
+}
+
+// AddDefects wraps AddDefectsWithContext using the background context
+func (s *StepResultService) AddDefects(stepResultID int, keys ...string) (*StepResult, *Response, error) {
+	return s.AddDefectsWithContext(context.Background(), stepResultID, keys...)
+}
+
+// RemoveDefectsWithContext unlinks the issues identified by keys from a step result
+func (s *StepResultService) RemoveDefectsWithContext(ctx context.Context, stepResultID int, keys ...string) (*StepResult, *Response, error) {
+	result, resp, err := s.GetWithContext(ctx, stepResultID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.setDefects(ctx, result, defectKeys(result.Defects, nil, keys))
+}
+
+// RemoveDefects wraps RemoveDefectsWithContext using the background context
+func (s *StepResultService) RemoveDefects(stepResultID int, keys ...string) (*StepResult, *Response, error) {
+	return s.RemoveDefectsWithContext(context.Background(), stepResultID, keys...)
+}
+
+func (s *StepResultService) setDefects(ctx context.Context, result *StepResult, keys []string) (*StepResult, *Response, error) {
+	if keys == nil {
+		keys = []string{}
+	}
+	body := &defectUpdate{
+		Status:           result.Status,
+		DefectList:       keys,
+		UpdateDefectList: "true",
+	}
+
+	endpoint := fmt.Sprintf(stepResultEndpointFormat, result.ID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, endpoint, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", DefectUpdateError, err)
+	}
+
+	reply := new(StepResult)
+	resp, err := s.client.Do(req, reply)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", DefectUpdateError, err)
+	}
+	return reply, resp, nil
+}
+
+// GetDefectsWithContext lists the defects linked to the executions of a cycle,
+// ordered by key. The executions are read page by page. Every defect is listed
+// once with all executions it is linked to. Only defects linked to executions
+// are listed, not those linked to the results of their steps.
+func (s *CycleService) GetDefectsWithContext(ctx context.Context, cycle *Cycle) ([]CycleDefect, *Response, error) {
+	opts := &ExecutionListOptions{
+		ProjectID: cycle.ProjectID,
+		VersionID: cycle.VersionID,
+		CycleID:   cycle.ID,
+	}
+	exes, resp, err := s.client.Execution.GetAllWithContext(ctx, opts)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", DefectListError, err)
+	}
+
+	byKey := make(map[string]*CycleDefect)
+	for _, exe := range exes {
+		for _, d := range exe.Defects {
+			cd, ok := byKey[d.Key]
+			if !ok {
+				cd = &CycleDefect{Defect: d}
+				byKey[d.Key] = cd
+			}
+			cd.ExecutionIDs = append(cd.ExecutionIDs, exe.ID)
+			cd.IssueKeys = append(cd.IssueKeys, exe.IssueKey)
+		}
+	}
+
+	defects := make([]CycleDefect, 0, len(byKey))
+	for _, cd := range byKey {
+		defects = append(defects, *cd)
+	}
+	sort.Slice(defects, func(i, j int) bool { return defects[i].Key < defects[j].Key })
+	return defects, resp, nil
+}
+
+// GetDefects wraps GetDefectsWithContext using the background context
+func (s *CycleService) GetDefects(cycle *Cycle) ([]CycleDefect, *Response, error) {
+	return s.GetDefectsWithContext(context.Background(), cycle)
+}
+
+// CountDefectsWithContext sets cycle.TotalDefects to the number of distinct
+// defects linked to the executions of the cycle, as listed by GetDefectsWithContext.
+func (s *CycleService) CountDefectsWithContext(ctx context.Context, cycle *Cycle) (*Response, error) {
+	defects, resp, err := s.GetDefectsWithContext(ctx, cycle)
+	if err != nil {
+		return resp, err
+	}
+	cycle.TotalDefects = len(defects)
+	return resp, nil
+}
+
+// CountDefects wraps CountDefectsWithContext using the background context
+func (s *CycleService) CountDefects(cycle *Cycle) (*Response, error) {
+	return s.CountDefectsWithContext(context.Background(), cycle)
+}
@@ -0,0 +1,244 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// testDefectUpdate serves the execution mock and captures the defect update sent for it
+func testDefectUpdate(t *testing.T) *map[string]interface{} {
+	raw, err := ioutil.ReadFile("./mocks/execution.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	body := new(map[string]interface{})
+	testMux.HandleFunc(fmt.Sprintf(executionItemEndpointFormat, 13377), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, string(raw))
+	})
+	testMux.HandleFunc(fmt.Sprintf(executeEndpointFormat, 13377), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, `{"id": 13377, "executionStatus": "2"}`)
+	})
+	return body
+}
+
+func TestExecutionService_Get_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	testDefectUpdate(t)
+
+	exe, _, err := testClient.Execution.Get(13377)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if exe.IssueKey != "SAM-14" {
+		t.Errorf("Expected issue SAM-14 but got %s", exe.IssueKey)
+	}
+	if len(exe.Defects) != 2 || exe.Defects[0].Key != "SAM-40" {
+		t.Errorf("Expected defects SAM-40 and SAM-41 but got %+v", exe.Defects)
+	}
+	if exe.TotalDefectCount != 2 {
+		t.Errorf("Expected total defect count 2 but got %d", exe.TotalDefectCount)
+	}
+}
+
+func TestExecutionService_Get_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(fmt.Sprintf(executionItemEndpointFormat, 13377), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	exe, _, err := testClient.Execution.Get(13377)
+	if exe != nil {
+		t.Errorf("Expected execution to be nil, %v", exe)
+	}
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestExecutionService_AddDefects(t *testing.T) {
+	setup()
+	defer teardown()
+	body := testDefectUpdate(t)
+
+	_, _, err := testClient.Execution.AddDefects(13377, "SAM-42", "SAM-40")
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	want := map[string]interface{}{
+		"status":           "2",
+		"defectList":       []interface{}{"SAM-40", "SAM-41", "SAM-42"},
+		"updateDefectList": "true",
+	}
+	if !reflect.DeepEqual(*body, want) {
+		t.Errorf("Expected body %v but got %v", want, *body)
+	}
+}
+
+func TestExecutionService_RemoveDefects_All(t *testing.T) {
+	setup()
+	defer teardown()
+	body := testDefectUpdate(t)
+
+	_, _, err := testClient.Execution.RemoveDefects(13377, "SAM-40", "SAM-41")
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	list, ok := (*body)["defectList"].([]interface{})
+	if !ok || len(list) != 0 {
+		t.Errorf("Expected an empty defectList but got %v", (*body)["defectList"])
+	}
+}
+
+func TestExecutionService_CreateDefect(t *testing.T) {
+	setup()
+	defer teardown()
+	body := testDefectUpdate(t)
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"id": "10050", "key": "SAM-50", "self": "http://my.jira.com/rest/api/2/issue/10050"}`)
+	})
+
+	issue, _, err := testClient.Execution.CreateDefect(13377, &Issue{Fields: &IssueFields{Summary: "Logout hangs"}})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if issue.Key != "SAM-50" {
+		t.Errorf("Expected issue SAM-50 but got %s", issue.Key)
+	}
+	want := []interface{}{"SAM-40", "SAM-41", "SAM-50"}
+	if !reflect.DeepEqual((*body)["defectList"], want) {
+		t.Errorf("Expected defectList %v but got %v", want, (*body)["defectList"])
+	}
+}
+
+func TestStepResultService_AddDefects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/step_result.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	body := map[string]interface{}{}
+	testMux.HandleFunc(fmt.Sprintf(stepResultEndpointFormat, 52), func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, string(raw))
+			return
+		}
+		testMethod(t, r, http.MethodPut)
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		fmt.Fprint(w, string(raw))
+	})
+
+	_, _, err = testClient.StepResult.AddDefects(52, "SAM-40")
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if body["status"] != "1" || !reflect.DeepEqual(body["defectList"], []interface{}{"SAM-40"}) {
+		t.Errorf("Unexpected defect update %v", body)
+	}
+}
+
+func TestCycleService_GetDefects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/executions_defects.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	var all executionList
+	if err := json.Unmarshal(raw, &all); err != nil {
+		t.Fatal(err)
+	}
+
+	// Two pages, of two executions and of one
+	defer func(size int) { executionPageSize = size }(executionPageSize)
+	executionPageSize = 2
+	var pages int
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		want := map[string]string{"projectId": "10000", "versionId": "10001", "cycleId": "100", "limit": "2"}
+		offset := 0
+		if r.URL.Query().Get("offset") != "" {
+			offset = 2
+			want["offset"] = "2"
+		}
+		testRequestParams(t, r, want)
+		pages++
+		end := offset + 2
+		if end > len(all.Executions) {
+			end = len(all.Executions)
+		}
+		json.NewEncoder(w).Encode(executionList{Executions: all.Executions[offset:end], RecordsCount: len(all.Executions)})
+	})
+
+	cycle := &Cycle{ID: 100, ProjectID: 10000, VersionID: 10001}
+	defects, _, err := testClient.Cycle.GetDefects(cycle)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if pages != 2 {
+		t.Errorf("Expected the executions to be read in 2 pages, got %d", pages)
+	}
+	if len(defects) != 2 {
+		t.Fatalf("Expected 2 defects but got %d", len(defects))
+	}
+	if defects[0].Key != "SAM-40" || !reflect.DeepEqual(defects[0].IssueKeys, []string{"SAM-14", "SAM-15"}) {
+		t.Errorf("Unexpected first defect %+v", defects[0])
+	}
+	if !reflect.DeepEqual(defects[1].ExecutionIDs, []int{13377}) {
+		t.Errorf("Expected SAM-41 to be linked to 13377 only, got %v", defects[1].ExecutionIDs)
+	}
+
+	if _, err := testClient.Cycle.CountDefects(cycle); err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if cycle.TotalDefects != 2 {
+		t.Errorf("Expected 2 defects but got %d", cycle.TotalDefects)
+	}
+}
+
+func TestCycleService_GetDefects_CappedLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/executions_defects.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	var all executionList
+	if err := json.Unmarshal(raw, &all); err != nil {
+		t.Fatal(err)
+	}
+
+	// The server returns a single execution per page whatever the limit
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		var offset int
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		json.NewEncoder(w).Encode(executionList{Executions: all.Executions[offset : offset+1], RecordsCount: len(all.Executions)})
+	})
+
+	defects, _, err := testClient.Cycle.GetDefects(&Cycle{ID: 100, ProjectID: 10000, VersionID: 10001})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(defects) != 2 || len(defects[0].ExecutionIDs) != 2 {
+		t.Errorf("Expected the defects of all executions, got %+v", defects)
+	}
+}
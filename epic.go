@@ -0,0 +1,171 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// EpicService handles epics in Jira Agile API.
+// Epics are identified by their issue id or key, so the "Epic Link" custom field is not needed.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/epic
+type EpicService struct {
+	client *Client
+}
+
+// UpdateEpicOptions are passed to the EpicService.Update function.
+// Only the fields that are set are changed.
+type UpdateEpicOptions struct {
+	Name    string     `json:"name,omitempty" structs:"name,omitempty"`
+	Summary string     `json:"summary,omitempty" structs:"summary,omitempty"`
+	Color   *EpicColor `json:"color,omitempty" structs:"color,omitempty"`
+	Done    *bool      `json:"done,omitempty" structs:"done,omitempty"`
+}
+
+// RankEpicOptions are passed to the EpicService.Rank function.
+// Exactly one of RankBeforeEpic and RankAfterEpic must be set.
+type RankEpicOptions struct {
+	RankBeforeEpic string `json:"rankBeforeEpic,omitempty" structs:"rankBeforeEpic,omitempty"`
+	RankAfterEpic  string `json:"rankAfterEpic,omitempty" structs:"rankAfterEpic,omitempty"`
+	// RankCustomFieldID is the ID of the rank field to use, the default rank field if 0
+	RankCustomFieldID int `json:"rankCustomFieldId,omitempty" structs:"rankCustomFieldId,omitempty"`
+}
+
+// GetWithContext returns the epic identified by epicIDOrKey
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/epic-getEpic
+func (s *EpicService) GetWithContext(ctx context.Context, epicIDOrKey string) (*Epic, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s", epicIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	epic := new(Epic)
+	resp, err := s.client.Do(req, epic)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return epic, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *EpicService) Get(epicIDOrKey string) (*Epic, *Response, error) {
+	return s.GetWithContext(context.Background(), epicIDOrKey)
+}
+
+// GetIssuesWithContext returns a page of the issues in the epic identified by epicIDOrKey, ordered by rank.
+// The paging values are set on the returned Response.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/epic-getIssuesForEpic
+func (s *EpicService) GetIssuesWithContext(ctx context.Context, epicIDOrKey string, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/issue", epicIDOrKey)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(searchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Issues, resp, nil
+}
+
+// GetIssues wraps GetIssuesWithContext using the background context.
+func (s *EpicService) GetIssues(epicIDOrKey string, options *BoardIssueOptions) ([]Issue, *Response, error) {
+	return s.GetIssuesWithContext(context.Background(), epicIDOrKey, options)
+}
+
+// MoveIssuesToEpicWithContext moves issues to the epic identified by epicIDOrKey.
+// Issues can be moved from one epic to another.
+// The maximum number of issues that can be moved in one operation is 50.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/epic-moveIssuesToEpic
+func (s *EpicService) MoveIssuesToEpicWithContext(ctx context.Context, epicIDOrKey string, issueIDs []string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/issue", epicIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, IssuesWrapper{Issues: issueIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// MoveIssuesToEpic wraps MoveIssuesToEpicWithContext using the background context.
+func (s *EpicService) MoveIssuesToEpic(epicIDOrKey string, issueIDs []string) (*Response, error) {
+	return s.MoveIssuesToEpicWithContext(context.Background(), epicIDOrKey, issueIDs)
+}
+
+// RemoveIssuesFromEpicWithContext removes issues from the epics they belong to.
+// The maximum number of issues that can be moved in one operation is 50.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/epic-removeIssuesFromEpic
+func (s *EpicService) RemoveIssuesFromEpicWithContext(ctx context.Context, issueIDs []string) (*Response, error) {
+	return s.MoveIssuesToEpicWithContext(ctx, "none", issueIDs)
+}
+
+// RemoveIssuesFromEpic wraps RemoveIssuesFromEpicWithContext using the background context.
+func (s *EpicService) RemoveIssuesFromEpic(issueIDs []string) (*Response, error) {
+	return s.RemoveIssuesFromEpicWithContext(context.Background(), issueIDs)
+}
+
+// UpdateWithContext partially updates the epic identified by epicIDOrKey.
+// Fields that are not set in options keep their value.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/epic-partiallyUpdateEpic
+func (s *EpicService) UpdateWithContext(ctx context.Context, epicIDOrKey string, options *UpdateEpicOptions) (*Epic, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s", epicIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	epic := new(Epic)
+	resp, err := s.client.Do(req, epic)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return epic, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *EpicService) Update(epicIDOrKey string, options *UpdateEpicOptions) (*Epic, *Response, error) {
+	return s.UpdateWithContext(context.Background(), epicIDOrKey, options)
+}
+
+// RankWithContext moves the epic identified by epicIDOrKey before or after another epic
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/epic-rankEpics
+func (s *EpicService) RankWithContext(ctx context.Context, epicIDOrKey string, options *RankEpicOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/rank", epicIDOrKey)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Rank wraps RankWithContext using the background context.
+func (s *EpicService) Rank(epicIDOrKey string, options *RankEpicOptions) (*Response, error) {
+	return s.RankWithContext(context.Background(), epicIDOrKey, options)
+}
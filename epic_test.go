@@ -0,0 +1,145 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestEpicService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/epic/PAY-12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/agile/1.0/epic/PAY-12")
+		fmt.Fprint(w, `{"id": 37, "key": "PAY-12", "self": "http://www.example.com/jira/rest/agile/1.0/epic/37", "name": "Checkout", "summary": "One page checkout", "color": {"key": "color_4"}, "done": false}`)
+	})
+
+	epic, _, err := testClient.Epic.Get("PAY-12")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if epic == nil || epic.ID != 37 || epic.Name != "Checkout" {
+		t.Fatalf("Unexpected epic %+v", epic)
+	}
+	if epic.Color == nil || epic.Color.Key != "color_4" {
+		t.Errorf("Expected color_4, got %+v", epic.Color)
+	}
+}
+
+func TestEpicService_Get_NoEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/epic/PAY-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["Issue does not exist or you do not have permission to see it."], "errors": {}}`)
+	})
+
+	epic, _, err := testClient.Epic.Get("PAY-1")
+	if epic != nil {
+		t.Errorf("Expected nil. Got %+v", epic)
+	}
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestEpicService_GetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/epic/PAY-12/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"fields": "summary", "startAt": "50"})
+		fmt.Fprint(w, `{"startAt": 50, "maxResults": 50, "total": 51, "issues": [{"id": "10040", "key": "PAY-22", "fields": {"summary": "Refund partially"}}]}`)
+	})
+
+	issues, resp, err := testClient.Epic.GetIssues("PAY-12", &BoardIssueOptions{Fields: []string{"summary"}, StartAt: 50})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "PAY-22" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+	if resp.StartAt != 50 || resp.Total != 51 {
+		t.Errorf("Unexpected paging values %+v", resp)
+	}
+}
+
+func TestEpicService_MoveIssuesToEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/epic/PAY-12/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var payload IssuesWrapper
+		json.NewDecoder(r.Body).Decode(&payload)
+		if len(payload.Issues) != 2 || payload.Issues[0] != "PAY-22" {
+			t.Errorf("Unexpected payload %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Epic.MoveIssuesToEpic("PAY-12", []string{"PAY-22", "PAY-23"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestEpicService_RemoveIssuesFromEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var payload IssuesWrapper
+		json.NewDecoder(r.Body).Decode(&payload)
+		if len(payload.Issues) != 1 || payload.Issues[0] != "PAY-22" {
+			t.Errorf("Unexpected payload %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Epic.RemoveIssuesFromEpic([]string{"PAY-22"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestEpicService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/epic/PAY-12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		color, _ := body["color"].(map[string]interface{})
+		if len(body) != 2 || body["done"] != true || color["key"] != "color_2" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		fmt.Fprint(w, `{"id": 37, "key": "PAY-12", "name": "Checkout", "color": {"key": "color_2"}, "done": true}`)
+	})
+
+	done := true
+	epic, _, err := testClient.Epic.Update("PAY-12", &UpdateEpicOptions{Color: &EpicColor{Key: "color_2"}, Done: &done})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if epic == nil || !epic.Done {
+		t.Errorf("Expected a done epic, got %+v", epic)
+	}
+}
+
+func TestEpicService_Rank(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/epic/PAY-12/rank", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["rankBeforeEpic"] != "PAY-20" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Epic.Rank("PAY-12", &RankEpicOptions{RankBeforeEpic: "PAY-20"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
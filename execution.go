@@ -7,6 +7,8 @@ import (
 )
 
 const (
+	ExecutionGetError     = "Execution Get Error"
+	ExecutionListError    = "Execution List Error"
 	ExecutionRequestError = "Execution Request Error"
 	ExecutionCreateError  = "Execution Create Error"
 	ExecuteRequestError   = "Execute Request Error"
@@ -14,37 +16,163 @@ const (
 )
 
 var (
-	executionEndpoint     = "/rest/zapi/latest/execution"
-	executeEndpointFormat = "/rest/zapi/latest/execution/%d/execute"
+	executionEndpoint           = "/rest/zapi/latest/execution"
+	executionItemEndpointFormat = "/rest/zapi/latest/execution/%d"
+	executeEndpointFormat       = "/rest/zapi/latest/execution/%d/execute"
 )
 
 type ExecutionService struct {
-	client *Client
+	client   *Client
+	statuses statusCache
 }
 
 type Execution struct {
-	ID              int    `json:"id"`
-	Name            string `json:"name,omitempty"`
-	AssignedTo      string `json:"assignedTo,omitempty"`
-	Component       string `json:"component,omitempty"`
-	CycleID         int    `json:"cycleId,omitempty"`
-	CycleName       string `json:"cycleName,omitempty"`
-	ExecutionStatus string `json:"executionStatus,omitempty"`
-	FolderID        int    `json:"folderId,omitempty"`
-	FolderName      string `json:"folderName,omitempty"`
-	IssueID         int    `json:"issueId,omitempty"`
-	IssueKey        string `json:"issueKey,omitempty"`
-	Label           string `json:"label,omitempty"`
-	ProjectID       int    `json:"projectId,omitempty"`
-	ProjectKey      string `json:"projectKey,omitempty"`
-	Summary         string `json:"summary,omitempty"`
-	VersionID       int    `json:"versionId,omitempty"`
-	VersionName     string `json:"versionName,omitempty"`
+	ID                int    `json:"id"`
+	Name              string `json:"name,omitempty"`
+	AssignedTo        string `json:"assignedTo,omitempty"`
+	Component         string `json:"component,omitempty"`
+	CycleID           int    `json:"cycleId,omitempty"`
+	CycleName         string `json:"cycleName,omitempty"`
+	ExecutionStatus   string `json:"executionStatus,omitempty"`
+	ExecutedBy        string `json:"executedBy,omitempty"`
+	ExecutedByDisplay string `json:"executedByDisplay,omitempty"`
+	ExecutedOn        string `json:"executedOn,omitempty"`
+	ExecutedOnVal     int64  `json:"executedOnVal,omitempty"`
+	Comment           string `json:"comment,omitempty"`
+	FolderID          int    `json:"folderId,omitempty"`
+	FolderName        string `json:"folderName,omitempty"`
+	IssueID           int    `json:"issueId,omitempty"`
+	IssueKey          string `json:"issueKey,omitempty"`
+	Label             string `json:"label,omitempty"`
+	ProjectID         int    `json:"projectId,omitempty"`
+	ProjectKey        string `json:"projectKey,omitempty"`
+	Summary           string `json:"summary,omitempty"`
+	VersionID         int    `json:"versionId,omitempty"`
+	VersionName       string `json:"versionName,omitempty"`
+
+	Defects              []Defect `json:"defects,omitempty"`
+	ExecutionDefectCount int      `json:"executionDefectCount,omitempty"`
+	StepDefectCount      int      `json:"stepDefectCount,omitempty"`
+	TotalDefectCount     int      `json:"totalDefectCount,omitempty"`
 }
 
+// ExecutionStatus is sent to ExecutionService.Execute.
+// Status is sent as a string, as the ZAPI expects it.
 type ExecutionStatus struct {
-	Status   string `json:"status,ommitempty"`
-	Assignee string `json:"assignee,ommitempty"`
+	Status   StatusID `json:"status,string,omitempty"`
+	Assignee string   `json:"assignee,omitempty"`
+	Comment  string   `json:"comment,omitempty"`
+}
+
+// ExecutionListOptions parameters to the ExecutionService.GetList
+type ExecutionListOptions struct {
+	IssueID   int `url:"issueId,omitempty"`
+	ProjectID int `url:"projectId,omitempty"`
+	VersionID int `url:"versionId,omitempty"`
+	CycleID   int `url:"cycleId,omitempty"`
+	FolderID  int `url:"folderId,omitempty"`
+	Offset    int `url:"offset,omitempty"`
+	Limit     int `url:"limit,omitempty"`
+}
+
+// executionList is the wrapper the execution listing is returned in
+type executionList struct {
+	Executions   []Execution `json:"executions"`
+	RecordsCount int         `json:"recordsCount"`
+}
+
+// executionPageSize is the number of executions GetAllWithContext requests
+// at a time unless the options set a Limit
+var executionPageSize = 50
+
+// GetListWithContext gets a list of executions, filtered by opts
+func (s *ExecutionService) GetListWithContext(ctx context.Context, opts *ExecutionListOptions) ([]Execution, *Response, error) {
+	list, resp, err := s.getList(ctx, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	return list.Executions, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context
+func (s *ExecutionService) GetList(opts *ExecutionListOptions) ([]Execution, *Response, error) {
+	return s.GetListWithContext(context.Background(), opts)
+}
+
+// GetAllWithContext gets all executions filtered by opts, page by page.
+// Paging starts at opts.Offset and stops once the recordsCount of the reply
+// is reached or a page is empty. opts is not modified.
+func (s *ExecutionService) GetAllWithContext(ctx context.Context, opts *ExecutionListOptions) ([]Execution, *Response, error) {
+	page := ExecutionListOptions{}
+	if opts != nil {
+		page = *opts
+	}
+	if page.Limit <= 0 {
+		page.Limit = executionPageSize
+	}
+
+	var all []Execution
+	for {
+		list, resp, err := s.getList(ctx, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, list.Executions...)
+		page.Offset += len(list.Executions)
+		if len(list.Executions) == 0 || page.Offset >= list.RecordsCount {
+			return all, resp, nil
+		}
+	}
+}
+
+// GetAll wraps GetAllWithContext using the background context
+func (s *ExecutionService) GetAll(opts *ExecutionListOptions) ([]Execution, *Response, error) {
+	return s.GetAllWithContext(context.Background(), opts)
+}
+
+// getList gets a single page of executions along with the total record count
+func (s *ExecutionService) getList(ctx context.Context, opts *ExecutionListOptions) (*executionList, *Response, error) {
+	url, err := addOptions(executionEndpoint, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", ExecutionListError, err)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", ExecutionListError, err)
+	}
+
+	list := new(executionList)
+	resp, err := s.client.Do(req, list)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", ExecutionListError, err)
+	}
+	return list, resp, nil
+}
+
+// GetWithContext gets a single execution
+func (s *ExecutionService) GetWithContext(ctx context.Context, exeID int) (*Execution, *Response, error) {
+	endpoint := fmt.Sprintf(executionItemEndpointFormat, exeID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", ExecutionGetError, err)
+	}
+
+	reply := new(struct {
+		Execution *Execution `json:"execution"`
+	})
+	resp, err := s.client.Do(req, reply)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", ExecutionGetError, err)
+	}
+	if reply.Execution == nil {
+		return nil, resp, fmt.Errorf("%s: execution %d not in reply", ExecutionGetError, exeID)
+	}
+	return reply.Execution, resp, nil
+}
+
+// Get wraps GetWithContext using the background context
+func (s *ExecutionService) Get(exeID int) (*Execution, *Response, error) {
+	return s.GetWithContext(context.Background(), exeID)
 }
 
 func (s *ExecutionService) CreateWithContext(ctx context.Context, exe *Execution) (*Execution, *Response, error) {
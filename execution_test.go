@@ -82,7 +82,7 @@ func TestExecutionService_Execute_Success(t *testing.T) {
 	defer teardown()
 
 	id := 13377
-	status := "-1"
+	status := StatusUnexecuted
 
 	raw, err := ioutil.ReadFile("./mocks/execution_execute.json")
 	if err != nil {
@@ -119,7 +119,7 @@ func TestExecutionService_Execute_Success(t *testing.T) {
 	if exe.ID != id {
 		t.Errorf("Expected id %d but got %d", id, exe.ID)
 	}
-	if exe.ExecutionStatus != status {
+	if exe.ExecutionStatus != status.String() {
 		t.Errorf("Expected ExecutionStatus %s but got %s", status, exe.ExecutionStatus)
 	}
 }
@@ -136,7 +136,7 @@ func TestExecutionService_Execute_RequestError(t *testing.T) {
 	executeEndpointFormat = "%d\r"
 
 	id := 1000
-	status := &ExecutionStatus{Status: "1"}
+	status := &ExecutionStatus{Status: StatusPass}
 	reply, _, err := testClient.Execution.Execute(id, status)
 
 	if reply != nil {
@@ -158,7 +158,7 @@ func TestExecutionService_Execute_HttpError(t *testing.T) {
 	})
 
 	id := 1000
-	status := &ExecutionStatus{Status: "1"}
+	status := &ExecutionStatus{Status: StatusPass}
 	reply, _, err := testClient.Execution.Execute(id, status)
 
 	if reply != nil {
@@ -168,3 +168,110 @@ func TestExecutionService_Execute_HttpError(t *testing.T) {
 		t.Errorf("No error given")
 	}
 }
+
+func TestExecutionService_GetList_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/all_executions.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, executionEndpoint)
+		testRequestParams(t, r, map[string]string{"cycleId": "100"})
+		fmt.Fprint(w, string(raw))
+	})
+
+	exes, _, err := testClient.Execution.GetList(&ExecutionListOptions{CycleID: 100})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if len(exes) != 2 {
+		t.Errorf("Expected %d executions but got %d", 2, len(exes))
+	}
+}
+
+func TestExecutionService_GetList_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	exes, _, err := testClient.Execution.GetList(nil)
+	if exes != nil {
+		t.Errorf("Actual execution list has %d entries but expected nil", len(exes))
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestExecutionService_GetAll_Pages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var offsets []string
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("limit"); got != "2" {
+			t.Errorf("Expected limit 2, got %q", got)
+		}
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		switch offset {
+		case "":
+			fmt.Fprint(w, `{"executions": [{"id": 1}, {"id": 2}], "recordsCount": 3}`)
+		case "2":
+			fmt.Fprint(w, `{"executions": [{"id": 3}], "recordsCount": 3}`)
+		default:
+			t.Errorf("Unexpected offset %q", offset)
+			fmt.Fprint(w, `{"executions": [], "recordsCount": 3}`)
+		}
+	})
+
+	opts := &ExecutionListOptions{CycleID: 100, Limit: 2}
+	exes, _, err := testClient.Execution.GetAll(opts)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(exes) != 3 || exes[2].ID != 3 {
+		t.Errorf("Expected executions 1, 2 and 3, got %+v", exes)
+	}
+	if len(offsets) != 2 {
+		t.Errorf("Expected 2 requests, got offsets %q", offsets)
+	}
+	if opts.Offset != 0 {
+		t.Errorf("Expected the options to be left unchanged, got offset %d", opts.Offset)
+	}
+}
+
+func TestExecutionService_GetAll_StopsOnEmptyPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprint(w, `{"executions": [{"id": 1}], "recordsCount": 5}`)
+			return
+		}
+		fmt.Fprint(w, `{"executions": [], "recordsCount": 5}`)
+	})
+
+	exes, _, err := testClient.Execution.GetAll(nil)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(exes) != 1 {
+		t.Errorf("Expected 1 execution, got %d", len(exes))
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
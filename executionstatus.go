@@ -0,0 +1,198 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	StatusCatalogueError = "Status Catalogue Error"
+	StatusUnknownError   = "Unknown Status Error"
+)
+
+var (
+	executionStatusEndpoint = "/rest/zapi/latest/util/testExecutionStatus"
+	stepStatusEndpoint      = "/rest/zapi/latest/util/teststepExecutionStatus"
+)
+
+// StatusID identifies a Zephyr execution or step status
+type StatusID int
+
+// Zephyr's built-in statuses. Instances may define additional, custom statuses.
+const (
+	StatusUnexecuted StatusID = -1
+	StatusPass       StatusID = 1
+	StatusFail       StatusID = 2
+	StatusWIP        StatusID = 3
+	StatusBlocked    StatusID = 4
+)
+
+// String returns the ID in the form the ZAPI uses it in request and reply bodies
+func (id StatusID) String() string {
+	return strconv.Itoa(int(id))
+}
+
+// TestStatus is an execution or step status as configured in Zephyr
+type TestStatus struct {
+	ID          StatusID `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Color       string   `json:"color,omitempty"`
+	Type        int      `json:"type,omitempty"`
+}
+
+// StatusCatalogue holds the execution and step statuses of a Zephyr instance
+type StatusCatalogue struct {
+	Execution []TestStatus
+	Step      []TestStatus
+}
+
+// ExecutionStatus looks up an execution status by name (case insensitive) or ID
+func (c *StatusCatalogue) ExecutionStatus(nameOrID string) (*TestStatus, error) {
+	return lookupStatus(c.Execution, nameOrID)
+}
+
+// StepStatus looks up a step status by name (case insensitive) or ID
+func (c *StatusCatalogue) StepStatus(nameOrID string) (*TestStatus, error) {
+	return lookupStatus(c.Step, nameOrID)
+}
+
+func lookupStatus(statuses []TestStatus, nameOrID string) (*TestStatus, error) {
+	nameOrID = strings.TrimSpace(nameOrID)
+	id, idErr := strconv.Atoi(nameOrID)
+	for i := range statuses {
+		if strings.EqualFold(statuses[i].Name, nameOrID) || (idErr == nil && int(statuses[i].ID) == id) {
+			return &statuses[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %q", StatusUnknownError, nameOrID)
+}
+
+// statusCache keeps the catalogue of a service once it has been loaded
+type statusCache struct {
+	mu        sync.Mutex
+	catalogue *StatusCatalogue
+}
+
+func (sc *statusCache) get(ctx context.Context, c *Client) (*StatusCatalogue, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.catalogue != nil {
+		return sc.catalogue, nil
+	}
+	catalogue, _, err := c.Execution.GetStatusCatalogueWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sc.catalogue = catalogue
+	return catalogue, nil
+}
+
+func (c *Client) getStatuses(ctx context.Context, endpoint string) ([]TestStatus, *Response, error) {
+	req, err := c.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", StatusCatalogueError, err)
+	}
+
+	var statuses []TestStatus
+	resp, err := c.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", StatusCatalogueError, err)
+	}
+	return statuses, resp, nil
+}
+
+// GetStatusesWithContext gets the execution statuses configured in Zephyr
+func (s *ExecutionService) GetStatusesWithContext(ctx context.Context) ([]TestStatus, *Response, error) {
+	return s.client.getStatuses(ctx, executionStatusEndpoint)
+}
+
+// GetStatuses wraps GetStatusesWithContext using the background context
+func (s *ExecutionService) GetStatuses() ([]TestStatus, *Response, error) {
+	return s.GetStatusesWithContext(context.Background())
+}
+
+// GetStatusesWithContext gets the step statuses configured in Zephyr
+func (s *StepResultService) GetStatusesWithContext(ctx context.Context) ([]TestStatus, *Response, error) {
+	return s.client.getStatuses(ctx, stepStatusEndpoint)
+}
+
+// GetStatuses wraps GetStatusesWithContext using the background context
+func (s *StepResultService) GetStatuses() ([]TestStatus, *Response, error) {
+	return s.GetStatusesWithContext(context.Background())
+}
+
+// GetStatusCatalogueWithContext gets both the execution and the step statuses
+func (s *ExecutionService) GetStatusCatalogueWithContext(ctx context.Context) (*StatusCatalogue, *Response, error) {
+	exeStatuses, resp, err := s.GetStatusesWithContext(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	stepStatuses, resp, err := s.client.StepResult.GetStatusesWithContext(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &StatusCatalogue{Execution: exeStatuses, Step: stepStatuses}, resp, nil
+}
+
+// GetStatusCatalogue wraps GetStatusCatalogueWithContext using the background context
+func (s *ExecutionService) GetStatusCatalogue() (*StatusCatalogue, *Response, error) {
+	return s.GetStatusCatalogueWithContext(context.Background())
+}
+
+// ExecuteByNameWithContext executes like ExecuteWithContext with the status
+// called name, like "PASS", or with the ID name. The status is looked up in the
+// catalogue, which is loaded on first use, before the execution is updated.
+// The assignee and comment are taken from status, which may be nil.
+func (s *ExecutionService) ExecuteByNameWithContext(ctx context.Context, exeID int, name string, status *ExecutionStatus) (*Execution, *Response, error) {
+	catalogue, err := s.statuses.get(ctx, s.client)
+	if err != nil {
+		return nil, nil, err
+	}
+	ts, err := catalogue.ExecutionStatus(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved := ExecutionStatus{}
+	if status != nil {
+		resolved = *status
+	}
+	resolved.Status = ts.ID
+	return s.ExecuteWithContext(ctx, exeID, &resolved)
+}
+
+// ExecuteByName wraps ExecuteByNameWithContext using the background context
+func (s *ExecutionService) ExecuteByName(exeID int, name string, status *ExecutionStatus) (*Execution, *Response, error) {
+	return s.ExecuteByNameWithContext(context.Background(), exeID, name, status)
+}
+
+// UpdateByNameWithContext updates like UpdateWithContext with the step status
+// called name, or with the ID name. The status is looked up before the update.
+// The comment is taken from update, which may be nil.
+func (s *StepResultService) UpdateByNameWithContext(ctx context.Context, stepResultID int, name string, update *StepResultUpdate) (*StepResult, *Response, error) {
+	catalogue, err := s.client.Execution.statuses.get(ctx, s.client)
+	if err != nil {
+		return nil, nil, err
+	}
+	ts, err := catalogue.StepStatus(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved := StepResultUpdate{}
+	if update != nil {
+		resolved = *update
+	}
+	resolved.Status = ts.ID
+	return s.UpdateWithContext(ctx, stepResultID, &resolved)
+}
+
+// UpdateByName wraps UpdateByNameWithContext using the background context
+func (s *StepResultService) UpdateByName(stepResultID int, name string, update *StepResultUpdate) (*StepResult, *Response, error) {
+	return s.UpdateByNameWithContext(context.Background(), stepResultID, name, update)
+}
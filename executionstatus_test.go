@@ -0,0 +1,153 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func setupStatusCatalogue(t *testing.T) {
+	for endpoint, mock := range map[string]string{
+		executionStatusEndpoint: "./mocks/execution_statuses.json",
+		stepStatusEndpoint:      "./mocks/step_statuses.json",
+	} {
+		raw, err := ioutil.ReadFile(mock)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		endpoint := endpoint
+		testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testRequestURL(t, r, endpoint)
+			fmt.Fprint(w, string(raw))
+		})
+	}
+}
+
+func TestExecutionService_GetStatusCatalogue_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	setupStatusCatalogue(t)
+
+	catalogue, _, err := testClient.Execution.GetStatusCatalogue()
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+		return
+	}
+	if len(catalogue.Execution) != 6 {
+		t.Errorf("Expected %d execution statuses but got %d", 6, len(catalogue.Execution))
+	}
+	if len(catalogue.Step) != 5 {
+		t.Errorf("Expected %d step statuses but got %d", 5, len(catalogue.Step))
+	}
+
+	flaky, err := catalogue.ExecutionStatus("flaky")
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	} else if flaky.ID != 5 || flaky.Color != "#990099" {
+		t.Errorf("Unexpected custom status %+v", flaky)
+	}
+	if pass, err := catalogue.ExecutionStatus("1"); err != nil || pass.Name != "PASS" {
+		t.Errorf("Expected lookup by ID to find PASS, got %v, %v", pass, err)
+	}
+	if _, err := catalogue.StepStatus("FLAKY"); err == nil {
+		t.Error("Expected unknown step status error")
+	}
+}
+
+func TestExecutionService_GetStatuses_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(executionStatusEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	statuses, _, err := testClient.Execution.GetStatuses()
+	if statuses != nil {
+		t.Errorf("Expected statuses to be nil, %v", statuses)
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestExecutionService_ExecuteByName_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	setupStatusCatalogue(t)
+
+	id := 13377
+	endpoint := fmt.Sprintf(executeEndpointFormat, id)
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		body := make(map[string]interface{})
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err.Error())
+		}
+		if body["status"] != StatusBlocked.String() {
+			t.Errorf("Server expected status %s but got %v", StatusBlocked, body["status"])
+		}
+		if _, ok := body["assignee"]; ok {
+			t.Error("Expected empty assignee to be omitted")
+		}
+		fmt.Fprintf(w, `{"id": %d, "executionStatus": "4"}`, id)
+	})
+
+	exe, _, err := testClient.Execution.ExecuteByName(id, "Blocked", nil)
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if exe == nil || exe.ExecutionStatus != "4" {
+		t.Errorf("Expected blocked execution but got %v", exe)
+	}
+}
+
+func TestExecutionService_ExecuteByName_UnknownStatus(t *testing.T) {
+	setup()
+	defer teardown()
+	setupStatusCatalogue(t)
+
+	id := 13377
+	testMux.HandleFunc(fmt.Sprintf(executeEndpointFormat, id), func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Execute must not be called with an unknown status")
+	})
+
+	exe, _, err := testClient.Execution.ExecuteByName(id, "DONE", &ExecutionStatus{Comment: "deployed"})
+	if exe != nil {
+		t.Errorf("Expected execution to be nil, %v", exe)
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestStepResultService_UpdateByName_Success(t *testing.T) {
+	setup()
+	defer teardown()
+	setupStatusCatalogue(t)
+
+	id := 52
+	testMux.HandleFunc(fmt.Sprintf(stepResultEndpointFormat, id), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		update := new(StepResultUpdate)
+		if err := json.NewDecoder(r.Body).Decode(update); err != nil {
+			t.Error(err.Error())
+		}
+		if update.Status != StatusFail {
+			t.Errorf("Server expected status %s but got %s", StatusFail, update.Status)
+		}
+		fmt.Fprintf(w, `{"id": %d, "status": %q}`, id, update.Status)
+	})
+
+	result, _, err := testClient.StepResult.UpdateByName(id, "fail", &StepResultUpdate{Comment: "timeout"})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if result == nil || result.Status != "2" {
+		t.Errorf("Expected failed step result but got %v", result)
+	}
+}
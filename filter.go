@@ -16,26 +16,87 @@ type FilterService struct {
 
 // Filter represents a Filter in Jira
 type Filter struct {
-	Self             string        `json:"self"`
-	ID               string        `json:"id"`
-	Name             string        `json:"name"`
-	Description      string        `json:"description"`
-	Owner            User          `json:"owner"`
-	Jql              string        `json:"jql"`
-	ViewURL          string        `json:"viewUrl"`
-	SearchURL        string        `json:"searchUrl"`
-	Favourite        bool          `json:"favourite"`
-	FavouritedCount  int           `json:"favouritedCount"`
-	SharePermissions []interface{} `json:"sharePermissions"`
+	Self             string                  `json:"self"`
+	ID               string                  `json:"id"`
+	Name             string                  `json:"name"`
+	Description      string                  `json:"description"`
+	Owner            User                    `json:"owner"`
+	Jql              string                  `json:"jql"`
+	ViewURL          string                  `json:"viewUrl"`
+	SearchURL        string                  `json:"searchUrl"`
+	Favourite        bool                    `json:"favourite"`
+	FavouritedCount  int                     `json:"favouritedCount"`
+	SharePermissions []FilterSharePermission `json:"sharePermissions"`
 	Subscriptions    struct {
-		Size       int           `json:"size"`
-		Items      []interface{} `json:"items"`
-		MaxResults int           `json:"max-results"`
-		StartIndex int           `json:"start-index"`
-		EndIndex   int           `json:"end-index"`
+		Size       int                  `json:"size"`
+		Items      []FilterSubscription `json:"items"`
+		MaxResults int                  `json:"max-results"`
+		StartIndex int                  `json:"start-index"`
+		EndIndex   int                  `json:"end-index"`
 	} `json:"subscriptions"`
 }
 
+// Share permission types of filters
+const (
+	FilterShareGlobal        = "global"
+	FilterShareAuthenticated = "authenticated"
+	FilterShareProject       = "project"
+	FilterShareProjectRole   = "projectRole"
+	FilterShareGroup         = "group"
+	FilterShareUser          = "user"
+)
+
+// FilterSharePermission shares a filter with everyone, the members of a project,
+// of a project role, of a group or with a user. A permission of type FilterShareProject
+// with a Role shares the filter with the project role.
+type FilterSharePermission struct {
+	ID      int         `json:"id" structs:"id"`
+	Type    string      `json:"type" structs:"type"`
+	Project *Project    `json:"project,omitempty" structs:"project,omitempty"`
+	Role    *Role       `json:"role,omitempty" structs:"role,omitempty"`
+	Group   *ShareGroup `json:"group,omitempty" structs:"group,omitempty"`
+	User    *User       `json:"user,omitempty" structs:"user,omitempty"`
+}
+
+// ShareGroup is a group a filter is shared with or subscribed by
+type ShareGroup struct {
+	Name string `json:"name" structs:"name"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+}
+
+// FilterSubscription is a subscription of a user or group to a filter
+type FilterSubscription struct {
+	ID    int         `json:"id" structs:"id"`
+	User  *User       `json:"user,omitempty" structs:"user,omitempty"`
+	Group *ShareGroup `json:"group,omitempty" structs:"group,omitempty"`
+}
+
+// CreateFilterOptions are passed to the FilterService.Create function to create a new filter
+type CreateFilterOptions struct {
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Jql         string `json:"jql" structs:"jql"`
+	Favourite   bool   `json:"favourite,omitempty" structs:"favourite,omitempty"`
+}
+
+// UpdateFilterOptions are passed to the FilterService.Update function.
+// Jira requires the name, the other fields keep their value if they are not set.
+type UpdateFilterOptions struct {
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Jql         string `json:"jql,omitempty" structs:"jql,omitempty"`
+}
+
+// AddSharePermissionOptions are passed to the FilterService.AddSharePermission function.
+// Which of the IDs is required depends on Type, e.g. ProjectID and ProjectRoleID for FilterShareProjectRole.
+type AddSharePermissionOptions struct {
+	Type          string `json:"type" structs:"type"`
+	ProjectID     string `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	ProjectRoleID string `json:"projectRoleId,omitempty" structs:"projectRoleId,omitempty"`
+	Groupname     string `json:"groupname,omitempty" structs:"groupname,omitempty"`
+	AccountID     string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+}
+
 // GetMyFiltersQueryOptions specifies the optional parameters for the Get My Filters method
 type GetMyFiltersQueryOptions struct {
 	IncludeFavourites bool   `url:"includeFavourites,omitempty"`
@@ -53,21 +114,18 @@ type FiltersList struct {
 
 // FiltersListItem represents a Filter of FiltersList in Jira
 type FiltersListItem struct {
-	Self             string        `json:"self"`
-	ID               string        `json:"id"`
-	Name             string        `json:"name"`
-	Description      string        `json:"description"`
-	Owner            User          `json:"owner"`
-	Jql              string        `json:"jql"`
-	ViewURL          string        `json:"viewUrl"`
-	SearchURL        string        `json:"searchUrl"`
-	Favourite        bool          `json:"favourite"`
-	FavouritedCount  int           `json:"favouritedCount"`
-	SharePermissions []interface{} `json:"sharePermissions"`
-	Subscriptions    []struct {
-		ID   int  `json:"id"`
-		User User `json:"user"`
-	} `json:"subscriptions"`
+	Self             string                  `json:"self"`
+	ID               string                  `json:"id"`
+	Name             string                  `json:"name"`
+	Description      string                  `json:"description"`
+	Owner            User                    `json:"owner"`
+	Jql              string                  `json:"jql"`
+	ViewURL          string                  `json:"viewUrl"`
+	SearchURL        string                  `json:"searchUrl"`
+	Favourite        bool                    `json:"favourite"`
+	FavouritedCount  int                     `json:"favouritedCount"`
+	SharePermissions []FilterSharePermission `json:"sharePermissions"`
+	Subscriptions    []FilterSubscription    `json:"subscriptions"`
 }
 
 // FilterSearchOptions specifies the optional parameters for the Search method
@@ -251,3 +309,211 @@ func (fs *FilterService) SearchWithContext(ctx context.Context, opt *FilterSearc
 func (fs *FilterService) Search(opt *FilterSearchOptions) (*FiltersList, *Response, error) {
 	return fs.SearchWithContext(context.Background(), opt)
 }
+
+// CreateWithContext creates a new filter owned by the user
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/filter-createFilter
+func (fs *FilterService) CreateWithContext(ctx context.Context, options *CreateFilterOptions) (*Filter, *Response, error) {
+	apiEndpoint := "rest/api/2/filter"
+	req, err := fs.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(Filter)
+	resp, err := fs.client.Do(req, filter)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return filter, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (fs *FilterService) Create(options *CreateFilterOptions) (*Filter, *Response, error) {
+	return fs.CreateWithContext(context.Background(), options)
+}
+
+// DeleteWithContext deletes the filter identified by filterID
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/filter-deleteFilter
+func (fs *FilterService) DeleteWithContext(ctx context.Context, filterID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fs.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (fs *FilterService) Delete(filterID int) (*Response, error) {
+	return fs.DeleteWithContext(context.Background(), filterID)
+}
+
+// UpdateWithContext updates the filter identified by filterID
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/filter-editFilter
+func (fs *FilterService) UpdateWithContext(ctx context.Context, filterID int, options *UpdateFilterOptions) (*Filter, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(Filter)
+	resp, err := fs.client.Do(req, filter)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return filter, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (fs *FilterService) Update(filterID int, options *UpdateFilterOptions) (*Filter, *Response, error) {
+	return fs.UpdateWithContext(context.Background(), filterID, options)
+}
+
+// ChangeOwnerWithContext makes the user identified by accountID the owner of the filter
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/#api-rest-api-3-filter-id-owner-put
+func (fs *FilterService) ChangeOwnerWithContext(ctx context.Context, filterID int, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/filter/%d/owner", filterID)
+	payload := struct {
+		AccountID string `json:"accountId"`
+	}{accountID}
+	req, err := fs.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fs.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// ChangeOwner wraps ChangeOwnerWithContext using the background context.
+func (fs *FilterService) ChangeOwner(filterID int, accountID string) (*Response, error) {
+	return fs.ChangeOwnerWithContext(context.Background(), filterID, accountID)
+}
+
+// GetSharePermissionsWithContext returns the share permissions of a filter
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/filter-getSharePermissions
+func (fs *FilterService) GetSharePermissionsWithContext(ctx context.Context, filterID int) ([]FilterSharePermission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/permission", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := []FilterSharePermission{}
+	resp, err := fs.client.Do(req, &permissions)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return permissions, resp, nil
+}
+
+// GetSharePermissions wraps GetSharePermissionsWithContext using the background context.
+func (fs *FilterService) GetSharePermissions(filterID int) ([]FilterSharePermission, *Response, error) {
+	return fs.GetSharePermissionsWithContext(context.Background(), filterID)
+}
+
+// AddSharePermissionWithContext shares a filter and returns all share permissions of the filter
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/filter-addSharePermission
+func (fs *FilterService) AddSharePermissionWithContext(ctx context.Context, filterID int, options *AddSharePermissionOptions) ([]FilterSharePermission, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/permission", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := []FilterSharePermission{}
+	resp, err := fs.client.Do(req, &permissions)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return permissions, resp, nil
+}
+
+// AddSharePermission wraps AddSharePermissionWithContext using the background context.
+func (fs *FilterService) AddSharePermission(filterID int, options *AddSharePermissionOptions) ([]FilterSharePermission, *Response, error) {
+	return fs.AddSharePermissionWithContext(context.Background(), filterID, options)
+}
+
+// RemoveSharePermissionWithContext removes the share permission identified by permissionID from a filter
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/filter-deleteSharePermission
+func (fs *FilterService) RemoveSharePermissionWithContext(ctx context.Context, filterID, permissionID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/permission/%d", filterID, permissionID)
+	req, err := fs.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := fs.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// RemoveSharePermission wraps RemoveSharePermissionWithContext using the background context.
+func (fs *FilterService) RemoveSharePermission(filterID, permissionID int) (*Response, error) {
+	return fs.RemoveSharePermissionWithContext(context.Background(), filterID, permissionID)
+}
+
+// SetFavouriteWithContext adds the filter to the favourites of the user
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-filter-id-favourite-put
+func (fs *FilterService) SetFavouriteWithContext(ctx context.Context, filterID int) (*Filter, *Response, error) {
+	return fs.favouriteWithContext(ctx, "PUT", filterID)
+}
+
+// SetFavourite wraps SetFavouriteWithContext using the background context.
+func (fs *FilterService) SetFavourite(filterID int) (*Filter, *Response, error) {
+	return fs.SetFavouriteWithContext(context.Background(), filterID)
+}
+
+// RemoveFavouriteWithContext removes the filter from the favourites of the user
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-filter-id-favourite-delete
+func (fs *FilterService) RemoveFavouriteWithContext(ctx context.Context, filterID int) (*Filter, *Response, error) {
+	return fs.favouriteWithContext(ctx, "DELETE", filterID)
+}
+
+// RemoveFavourite wraps RemoveFavouriteWithContext using the background context.
+func (fs *FilterService) RemoveFavourite(filterID int) (*Filter, *Response, error) {
+	return fs.RemoveFavouriteWithContext(context.Background(), filterID)
+}
+
+func (fs *FilterService) favouriteWithContext(ctx context.Context, method string, filterID int) (*Filter, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d/favourite", filterID)
+	req, err := fs.client.NewRequestWithContext(ctx, method, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := new(Filter)
+	resp, err := fs.client.Do(req, filter)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return filter, resp, nil
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -97,7 +98,11 @@ func TestFilterService_GetMyFilters(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 	if filters == nil {
-		t.Errorf("Expected Filters, got nil")
+		t.Fatal("Expected Filters, got nil")
+	}
+	permissions := filters[1].SharePermissions
+	if len(permissions) != 2 || permissions[0].Type != FilterShareGlobal || permissions[1].Project == nil || permissions[1].Project.Key != "EX" {
+		t.Errorf("Unexpected share permissions %+v", permissions)
 	}
 }
 
@@ -121,6 +126,173 @@ func TestFilterService_Search(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 	if filters == nil {
-		t.Errorf("Expected Filters, got nil")
+		t.Fatal("Expected Filters, got nil")
+	}
+	subscriptions := filters.Values[1].Subscriptions
+	if len(subscriptions) != 1 || subscriptions[0].User == nil || subscriptions[0].User.EmailAddress != "mia@example.com" {
+		t.Errorf("Unexpected subscriptions %+v", subscriptions)
+	}
+}
+
+func TestFilterService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/filter")
+		fmt.Fprint(w, `{"id": "10020", "name": "Payments", "jql": "project = PAY"}`)
+	})
+
+	filter, _, err := testClient.Filter.Create(&CreateFilterOptions{Name: "Payments", Jql: "project = PAY"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter == nil || filter.ID != "10020" {
+		t.Errorf("Unexpected filter %+v", filter)
+	}
+}
+
+func TestFilterService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10020", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Filter.Delete(10020); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFilterService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10020", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 2 || body["name"] != "Payments" || body["jql"] != "project = PAY AND resolution is EMPTY" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		fmt.Fprint(w, `{"id": "10020", "name": "Payments", "jql": "project = PAY AND resolution is EMPTY"}`)
+	})
+
+	filter, _, err := testClient.Filter.Update(10020, &UpdateFilterOptions{Name: "Payments", Jql: "project = PAY AND resolution is EMPTY"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter == nil || filter.Jql != "project = PAY AND resolution is EMPTY" {
+		t.Errorf("Unexpected filter %+v", filter)
+	}
+}
+
+func TestFilterService_ChangeOwner(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/filter/10020/owner", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["accountId"] != "5b10ac8d82e05b22cc7d4ef5" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Filter.ChangeOwner(10020, "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFilterService_GetSharePermissions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10020/permission", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{"id": 10000, "type": "global"},
+			{"id": 10010, "type": "project", "project": {"id": "10000", "key": "PAY"}, "role": {"id": 10360, "name": "Developers"}},
+			{"id": 10020, "type": "group", "group": {"name": "payments-team"}},
+			{"id": 10030, "type": "user", "user": {"accountId": "5b10ac8d82e05b22cc7d4ef5"}}]`)
+	})
+
+	permissions, _, err := testClient.Filter.GetSharePermissions(10020)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(permissions) != 4 {
+		t.Fatalf("Expected 4 share permissions, got %d", len(permissions))
+	}
+	if p := permissions[1]; p.Project == nil || p.Project.Key != "PAY" || p.Role == nil || p.Role.ID != 10360 {
+		t.Errorf("Expected the project role Developers of PAY, got %+v", p)
+	}
+	if p := permissions[2]; p.Type != FilterShareGroup || p.Group == nil || p.Group.Name != "payments-team" {
+		t.Errorf("Expected the group payments-team, got %+v", p)
+	}
+	if p := permissions[3]; p.Type != FilterShareUser || p.User == nil || p.User.AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Expected a user, got %+v", p)
+	}
+}
+
+func TestFilterService_AddSharePermission(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10020/permission", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 3 || body["type"] != FilterShareProjectRole || body["projectId"] != "10000" || body["projectRoleId"] != "10360" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `[{"id": 10010, "type": "project", "project": {"id": "10000", "key": "PAY"}, "role": {"id": 10360, "name": "Developers"}}]`)
+	})
+
+	permissions, _, err := testClient.Filter.AddSharePermission(10020, &AddSharePermissionOptions{Type: FilterShareProjectRole, ProjectID: "10000", ProjectRoleID: "10360"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(permissions) != 1 || permissions[0].ID != 10010 {
+		t.Errorf("Unexpected share permissions %+v", permissions)
+	}
+}
+
+func TestFilterService_RemoveSharePermission(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/filter/10020/permission/10010", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Filter.RemoveSharePermission(10020, 10010); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFilterService_Favourite(t *testing.T) {
+	setup()
+	defer teardown()
+	favourite := false
+	testMux.HandleFunc("/rest/api/2/filter/10020/favourite", func(w http.ResponseWriter, r *http.Request) {
+		favourite = r.Method == "PUT"
+		fmt.Fprintf(w, `{"id": "10020", "name": "Payments", "favourite": %v}`, favourite)
+	})
+
+	filter, _, err := testClient.Filter.SetFavourite(10020)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter == nil || !filter.Favourite {
+		t.Errorf("Expected a favourite filter, got %+v", filter)
+	}
+
+	filter, _, err = testClient.Filter.RemoveFavourite(10020)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if filter == nil || filter.Favourite {
+		t.Errorf("Expected no favourite filter, got %+v", filter)
 	}
 }
@@ -2,44 +2,83 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
 const (
 	FolderListError   = "Folder List Error"
 	FolderCreateError = "Folder Create Error"
+	FolderUpdateError = "Folder Update Error"
+	FolderDeleteError = "Folder Delete Error"
+	FolderCopyError   = "Folder Copy Error"
 )
 
 var (
-	folderEndpointFormat = "/rest/zapi/latest/cycle/%d/folders"
-	folderEndpoint       = "/rest/zapi/latest/folder/create"
+	folderEndpointFormat     = "/rest/zapi/latest/cycle/%d/folders"
+	folderEndpoint           = "/rest/zapi/latest/folder/create"
+	folderItemEndpointFormat = "/rest/zapi/latest/folder/%d"
 )
 
 type FolderService struct {
 	client *Client
 }
 
+// Folder represents a folder within a Zephyr cycle.
+// The ZAPI endpoints disagree on the names of the ID, name and description
+// fields, Folder accepts all of them when decoding.
 type Folder struct {
-	CycleID     int    `json:"cycleId,omitempty"`
-	CycleName   string `json:"cycleName,omitempty"`
-	Description string `json:"folderDescription,omitempty"`
-	FolderID    int    `json:"folderId,omitempty"`
-	FolderName  string `json:"folderName,omitempty"`
 	ID          int    `json:"id,omitempty"`
 	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	CycleID     int    `json:"cycleId,omitempty"`
+	CycleName   string `json:"cycleName,omitempty"`
 	ProjectID   int    `json:"projectId,omitempty"`
 	ProjectKey  string `json:"projectKey,omitempty"`
 	VersionID   int    `json:"versionId,omitempty"`
 	VersionName string `json:"versionName,omitempty"`
 }
 
+// UnmarshalJSON decodes a folder from any of the representations the ZAPI uses
+func (f *Folder) UnmarshalJSON(data []byte) error {
+	type folder Folder
+	raw := struct {
+		*folder
+		FolderID          int    `json:"folderId"`
+		FolderName        string `json:"folderName"`
+		FolderDescription string `json:"folderDescription"`
+	}{folder: (*folder)(f)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if f.ID == 0 {
+		f.ID = raw.FolderID
+	}
+	if f.Name == "" {
+		f.Name = raw.FolderName
+	}
+	if f.Description == "" {
+		f.Description = raw.FolderDescription
+	}
+	return nil
+}
+
 // FolderListOptions parameters to the FolderService.GetList
 type FolderListOptions struct {
 	ProjectID int `url:"projectId"`
 	VersionID int `url:"versionId"`
 }
 
+// FolderDeleteOptions parameters to the FolderService.Delete
+type FolderDeleteOptions struct {
+	CycleID   int `url:"cycleId"`
+	ProjectID int `url:"projectId"`
+	VersionID int `url:"versionId"`
+}
+
 // GetListWithContext gets a list of folders
 func (s *FolderService) GetListWithContext(ctx context.Context, cycleId int, opts *FolderListOptions) ([]Folder, *Response, error) {
 	// setup url
@@ -91,3 +130,220 @@ func (s *FolderService) CreateWithContext(ctx context.Context, folder *Folder) (
 func (s *FolderService) Create(folder *Folder) (*Folder, *Response, error) {
 	return s.CreateWithContext(context.Background(), folder)
 }
+
+// GetAllWithContext gets the folders of all cycles of a project version.
+// The CycleID and CycleName of every returned folder is set.
+func (s *FolderService) GetAllWithContext(ctx context.Context, opts *FolderListOptions) ([]Folder, *Response, error) {
+	cycleOpts := new(CycleListOptions)
+	if opts != nil {
+		cycleOpts.ProjectID = opts.ProjectID
+		cycleOpts.VersionID = opts.VersionID
+	}
+	cycles, resp, err := s.client.Cycle.GetListWithContext(ctx, cycleOpts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var all []Folder
+	for _, cycle := range cycles {
+		folders, resp, err := s.GetListWithContext(ctx, cycle.ID, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, f := range folders {
+			f.CycleID = cycle.ID
+			f.CycleName = cycle.Name
+			all = append(all, f)
+		}
+	}
+	return all, resp, nil
+}
+
+// GetAll wraps GetAllWithContext using the background context
+func (s *FolderService) GetAll(opts *FolderListOptions) ([]Folder, *Response, error) {
+	return s.GetAllWithContext(context.Background(), opts)
+}
+
+// UpdateWithContext updates the name and description of the folder identified by folder.ID
+func (s *FolderService) UpdateWithContext(ctx context.Context, folder *Folder) (*Folder, *Response, error) {
+	endpoint := fmt.Sprintf(folderItemEndpointFormat, folder.ID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, endpoint, folder)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", FolderUpdateError, err)
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", FolderUpdateError, err)
+	}
+
+	// the reply only holds a response message, return a copy of the folder instead
+	ret := *folder
+	return &ret, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context
+func (s *FolderService) Update(folder *Folder) (*Folder, *Response, error) {
+	return s.UpdateWithContext(context.Background(), folder)
+}
+
+// DeleteWithContext deletes a folder and the executions within it
+func (s *FolderService) DeleteWithContext(ctx context.Context, folderID int, opts *FolderDeleteOptions) (*Response, error) {
+	url, err := addOptions(fmt.Sprintf(folderItemEndpointFormat, folderID), opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", FolderDeleteError, err)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", FolderDeleteError, err)
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", FolderDeleteError, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context
+func (s *FolderService) Delete(folderID int, opts *FolderDeleteOptions) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), folderID, opts)
+}
+
+// FolderCopyOptions are passed to FolderService.Copy
+type FolderCopyOptions struct {
+	// DeleteSource deletes the copied folder, and with it the execution
+	// history, step results and attachments of its executions, once all
+	// executions have been copied
+	DeleteSource bool
+}
+
+// CopyWithContext copies a folder into another cycle of the same project version.
+// The ZAPI cannot move folders, so a folder of the same name is created in the
+// target cycle and the executions of the folder are added to it with their
+// status, comment and defects. Their execution history, step results and
+// attachments are not copied. The source folder is kept unless
+// opts.DeleteSource is set. If a step fails the source folder is kept, and
+// the error tells how far the copy got.
+func (s *FolderService) CopyWithContext(ctx context.Context, folder *Folder, cycleID int, opts *FolderCopyOptions) (*Folder, *Response, error) {
+	exes, resp, err := s.client.Execution.GetAllWithContext(ctx, &ExecutionListOptions{
+		ProjectID: folder.ProjectID,
+		VersionID: folder.VersionID,
+		CycleID:   folder.CycleID,
+		FolderID:  folder.ID,
+	})
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", FolderCopyError, err)
+	}
+
+	created, resp, err := s.CreateWithContext(ctx, &Folder{
+		Name:        folder.Name,
+		Description: folder.Description,
+		CycleID:     cycleID,
+		ProjectID:   folder.ProjectID,
+		VersionID:   folder.VersionID,
+	})
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", FolderCopyError, err)
+	}
+	copied := *folder
+	copied.ID = created.ID
+	copied.CycleID = cycleID
+	copied.CycleName = ""
+
+	for _, exe := range exes {
+		resp, err = s.copyExecution(ctx, &exe, &copied)
+		if err != nil {
+			return nil, resp, fmt.Errorf("%s: folder %d created in cycle %d, copying execution %d: %w", FolderCopyError, copied.ID, cycleID, exe.ID, err)
+		}
+	}
+	if opts == nil || !opts.DeleteSource {
+		return &copied, resp, nil
+	}
+
+	resp, err = s.DeleteWithContext(ctx, folder.ID, &FolderDeleteOptions{CycleID: folder.CycleID, ProjectID: folder.ProjectID, VersionID: folder.VersionID})
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: folder %d created in cycle %d with all executions: %w", FolderCopyError, copied.ID, cycleID, err)
+	}
+	return &copied, resp, nil
+}
+
+// copyExecution adds the test of exe to folder with the status, comment and defects of exe
+func (s *FolderService) copyExecution(ctx context.Context, exe *Execution, folder *Folder) (*Response, error) {
+	created, resp, err := s.client.Execution.CreateWithContext(ctx, &Execution{
+		IssueID:   exe.IssueID,
+		ProjectID: folder.ProjectID,
+		VersionID: folder.VersionID,
+		CycleID:   folder.CycleID,
+		FolderID:  folder.ID,
+	})
+	if err != nil {
+		return resp, err
+	}
+	if created == nil || created.ID == 0 {
+		return resp, fmt.Errorf("no execution in reply")
+	}
+
+	if id, err := strconv.Atoi(exe.ExecutionStatus); err == nil && StatusID(id) != StatusUnexecuted {
+		status := &ExecutionStatus{Status: StatusID(id), Comment: exe.Comment}
+		if _, resp, err = s.client.Execution.ExecuteWithContext(ctx, created.ID, status); err != nil {
+			return resp, err
+		}
+	}
+	if len(exe.Defects) > 0 {
+		created.ExecutionStatus = exe.ExecutionStatus
+		if _, resp, err = s.client.Execution.setDefects(ctx, created, defectKeys(exe.Defects, nil, nil)); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// Copy wraps CopyWithContext using the background context
+func (s *FolderService) Copy(folder *Folder, cycleID int, opts *FolderCopyOptions) (*Folder, *Response, error) {
+	return s.CopyWithContext(context.Background(), folder, cycleID, opts)
+}
+
+// FindWithContext looks up a folder by name within a cycle.
+// It returns a nil folder and no error if there is no such folder.
+func (s *FolderService) FindWithContext(ctx context.Context, cycleID int, name string, opts *FolderListOptions) (*Folder, *Response, error) {
+	folders, resp, err := s.GetListWithContext(ctx, cycleID, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	for i := range folders {
+		if folders[i].Name == name {
+			folders[i].CycleID = cycleID
+			return &folders[i], resp, nil
+		}
+	}
+	return nil, resp, nil
+}
+
+// Find wraps FindWithContext using the background context
+func (s *FolderService) Find(cycleID int, name string, opts *FolderListOptions) (*Folder, *Response, error) {
+	return s.FindWithContext(context.Background(), cycleID, name, opts)
+}
+
+// FindOrCreateWithContext returns the folder named folder.Name within folder.CycleID,
+// creating it if it does not exist yet. This makes repeated runs of scripts idempotent.
+func (s *FolderService) FindOrCreateWithContext(ctx context.Context, folder *Folder) (*Folder, *Response, error) {
+	opts := &FolderListOptions{ProjectID: folder.ProjectID, VersionID: folder.VersionID}
+	found, resp, err := s.FindWithContext(ctx, folder.CycleID, folder.Name, opts)
+	if err != nil || found != nil {
+		return found, resp, err
+	}
+
+	reply, resp, err := s.CreateWithContext(ctx, folder)
+	if err != nil {
+		return nil, resp, err
+	}
+	created := *folder
+	created.ID = reply.ID
+	return &created, resp, nil
+}
+
+// FindOrCreate wraps FindOrCreateWithContext using the background context
+func (s *FolderService) FindOrCreate(folder *Folder) (*Folder, *Response, error) {
+	return s.FindOrCreateWithContext(context.Background(), folder)
+}
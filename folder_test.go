@@ -1,9 +1,11 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -195,3 +197,258 @@ func TestFolderService_GetList_HttpError(t *testing.T) {
 // 		t.Errorf("Expected id 54 but got %s", reply.ID)
 // 	}
 // }
+
+func TestFolder_UnmarshalJSON(t *testing.T) {
+	raw, err := ioutil.ReadFile("./mocks/all_folders.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	var folders []Folder
+	if err := json.Unmarshal(raw, &folders); err != nil {
+		t.Errorf("Error given: %v", err)
+		return
+	}
+	if folders[0].ID != 1234 || folders[0].Name != "testfolder" {
+		t.Errorf("Expected folder 1234 testfolder but got %d %s", folders[0].ID, folders[0].Name)
+	}
+	if folders[0].Description != "created test folder for this cycle" {
+		t.Errorf("Unexpected description %q", folders[0].Description)
+	}
+
+	folder := new(Folder)
+	if err := json.Unmarshal([]byte(`{"folderId": 7, "name": "api", "cycleId": 3}`), folder); err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if folder.ID != 7 || folder.Name != "api" || folder.CycleID != 3 {
+		t.Errorf("Unexpected folder %+v", folder)
+	}
+}
+
+func TestFolderService_Copy_DeleteSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			testRequestParams(t, r, map[string]string{"projectId": "10000", "versionId": "-1", "cycleId": "1", "folderId": "1234", "limit": "50"})
+			fmt.Fprint(w, `{"executions": [
+				{"id": 11, "issueId": 10013, "executionStatus": "2", "comment": "timeout", "defects": [{"key": "SAM-40"}]},
+				{"id": 12, "issueId": 10014, "executionStatus": "-1"}], "recordsCount": 2}`)
+			return
+		}
+		var exe Execution
+		json.NewDecoder(r.Body).Decode(&exe)
+		if exe.CycleID != 2 || exe.FolderID != 4321 || exe.ProjectID != 10000 || exe.VersionID != -1 {
+			t.Errorf("Unexpected execution %+v", exe)
+		}
+		requests = append(requests, fmt.Sprintf("create %d", exe.IssueID))
+		fmt.Fprintf(w, `{"%d": {"id": %d, "issueId": %d}}`, exe.IssueID+10000, exe.IssueID+10000, exe.IssueID)
+	})
+	testMux.HandleFunc(fmt.Sprintf(executeEndpointFormat, 20013), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, "execute "+strings.TrimSpace(string(body)))
+		fmt.Fprint(w, `{"id": 20013}`)
+	})
+	testMux.HandleFunc(folderEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var f Folder
+		json.NewDecoder(r.Body).Decode(&f)
+		if f.Name != "api" || f.Description != "API tests" || f.CycleID != 2 {
+			t.Errorf("Unexpected folder %+v", f)
+		}
+		requests = append(requests, "create folder")
+		fmt.Fprint(w, `{"id": 4321, "responseMessage": "Folder api created successfully."}`)
+	})
+	testMux.HandleFunc(fmt.Sprintf(folderItemEndpointFormat, 1234), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestParams(t, r, map[string]string{"cycleId": "1", "projectId": "10000", "versionId": "-1"})
+		requests = append(requests, "delete folder")
+		fmt.Fprint(w, `{"success": "Folder deleted"}`)
+	})
+
+	folder, _, err := testClient.Folder.Copy(&Folder{ID: 1234, Name: "api", Description: "API tests", CycleID: 1, CycleName: "old", ProjectID: 10000, VersionID: -1}, 2, &FolderCopyOptions{DeleteSource: true})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if folder.ID != 4321 || folder.CycleID != 2 || folder.Name != "api" {
+		t.Errorf("Expected folder 4321 in cycle 2 but got %+v", folder)
+	}
+	want := []string{
+		"create folder",
+		"create 10013",
+		`execute {"status":"2","comment":"timeout"}`,
+		`execute {"status":"2","defectList":["SAM-40"],"updateDefectList":"true"}`,
+		"create 10014",
+		"delete folder",
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected requests:\n%s\nwant:\n%s", strings.Join(requests, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestFolderService_Copy_KeepsSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"executions": [{"id": 11, "issueId": 10013, "executionStatus": "-1"}], "recordsCount": 1}`)
+			return
+		}
+		fmt.Fprint(w, `{"20013": {"id": 20013, "issueId": 10013}}`)
+	})
+	testMux.HandleFunc(folderEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 4321}`)
+	})
+	testMux.HandleFunc(fmt.Sprintf(folderItemEndpointFormat, 1234), func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the source folder to be kept")
+	})
+
+	folder, _, err := testClient.Folder.Copy(&Folder{ID: 1234, Name: "api", CycleID: 1, ProjectID: 10000, VersionID: -1}, 2, nil)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if folder.ID != 4321 || folder.CycleID != 2 {
+		t.Errorf("Expected folder 4321 in cycle 2 but got %+v", folder)
+	}
+}
+
+func TestFolderService_Copy_KeepsFolderOnError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(executionEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"executions": [{"id": 11, "issueId": 10013}], "recordsCount": 1}`)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	testMux.HandleFunc(folderEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 4321}`)
+	})
+	testMux.HandleFunc(fmt.Sprintf(folderItemEndpointFormat, 1234), func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the old folder to be kept")
+	})
+
+	_, _, err := testClient.Folder.Copy(&Folder{ID: 1234, Name: "api", CycleID: 1, ProjectID: 10000, VersionID: -1}, 2, &FolderCopyOptions{DeleteSource: true})
+	if err == nil || !strings.Contains(err.Error(), "folder 4321 created in cycle 2, copying execution 11") {
+		t.Errorf("Expected the failed copy to be reported, got %v", err)
+	}
+}
+
+func TestFolderService_Delete_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	endpoint := fmt.Sprintf(folderItemEndpointFormat, 1234)
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, endpoint)
+		testRequestParams(t, r, map[string]string{"cycleId": "1", "projectId": "10000", "versionId": "-1"})
+		fmt.Fprint(w, `{"success": "Folder deleted"}`)
+	})
+
+	_, err := testClient.Folder.Delete(1234, &FolderDeleteOptions{CycleID: 1, ProjectID: 10000, VersionID: -1})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+}
+
+func TestFolderService_Delete_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	endpoint := fmt.Sprintf(folderItemEndpointFormat, 1234)
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := testClient.Folder.Delete(1234, nil); err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestFolderService_GetAll_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cycles, err := ioutil.ReadFile("./mocks/all_cycles.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	folders, err := ioutil.ReadFile("./mocks/all_folders.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(cycleEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, string(cycles))
+	})
+	for _, id := range []int{1234, 5678} {
+		testMux.HandleFunc(fmt.Sprintf(folderEndpointFormat, id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			fmt.Fprint(w, string(folders))
+		})
+	}
+
+	all, _, err := testClient.Folder.GetAll(&FolderListOptions{ProjectID: 1, VersionID: 1})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if len(all) != 4 {
+		t.Errorf("Expected %d folders but got %d", 4, len(all))
+	}
+	for _, f := range all {
+		if f.CycleID != 1234 && f.CycleID != 5678 {
+			t.Errorf("Expected folder to carry its cycle, got %d", f.CycleID)
+		}
+	}
+}
+
+func TestFolderService_FindOrCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cycleId := 1
+	folders, err := ioutil.ReadFile("./mocks/all_folders.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	created, err := ioutil.ReadFile("./mocks/folder_create.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(fmt.Sprintf(folderEndpointFormat, cycleId), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, string(folders))
+	})
+	creates := 0
+	testMux.HandleFunc(folderEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		creates++
+		fmt.Fprint(w, string(created))
+	})
+
+	existing, _, err := testClient.Folder.FindOrCreate(&Folder{CycleID: cycleId, Name: "testfolder2"})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if existing == nil || existing.ID != 5678 {
+		t.Errorf("Expected existing folder 5678 but got %v", existing)
+	}
+
+	folder, _, err := testClient.Folder.FindOrCreate(&Folder{CycleID: cycleId, Name: "nightly-api"})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if folder == nil || folder.ID != 4321 || folder.Name != "nightly-api" {
+		t.Errorf("Expected new folder 4321 but got %v", folder)
+	}
+	if creates != 1 {
+		t.Errorf("Expected %d folder creation but got %d", 1, creates)
+	}
+}
@@ -175,3 +175,57 @@ func (s *GroupService) RemoveWithContext(ctx context.Context, groupname string,
 func (s *GroupService) Remove(groupname string, username string) (*Response, error) {
 	return s.RemoveWithContext(context.Background(), groupname, username)
 }
+
+// AddByAccountIDWithContext adds the user identified by accountID to group.
+// Jira Cloud identifies users by account ID instead of username.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-user-post
+func (s *GroupService) AddByAccountIDWithContext(ctx context.Context, groupname string, accountID string) (*Group, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s", url.QueryEscape(groupname))
+	var user struct {
+		AccountID string `json:"accountId"`
+	}
+	user.AccountID = accountID
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, &user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseGroup := new(Group)
+	resp, err := s.client.Do(req, responseGroup)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return nil, resp, jerr
+	}
+
+	return responseGroup, resp, nil
+}
+
+// AddByAccountID wraps AddByAccountIDWithContext using the background context.
+func (s *GroupService) AddByAccountID(groupname string, accountID string) (*Group, *Response, error) {
+	return s.AddByAccountIDWithContext(context.Background(), groupname, accountID)
+}
+
+// RemoveByAccountIDWithContext removes the user identified by accountID from group.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-groups/#api-rest-api-2-group-user-delete
+func (s *GroupService) RemoveByAccountIDWithContext(ctx context.Context, groupname string, accountID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?groupname=%s&accountId=%s", url.QueryEscape(groupname), url.QueryEscape(accountID))
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		jerr := NewJiraError(resp, err)
+		return resp, jerr
+	}
+
+	return resp, nil
+}
+
+// RemoveByAccountID wraps RemoveByAccountIDWithContext using the background context.
+func (s *GroupService) RemoveByAccountID(groupname string, accountID string) (*Response, error) {
+	return s.RemoveByAccountIDWithContext(context.Background(), groupname, accountID)
+}
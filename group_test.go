@@ -2,7 +2,9 @@ package jira
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -109,3 +111,40 @@ func TestGroupService_Remove(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestGroupService_AddByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, "/rest/api/2/group/user?groupname=default")
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if got := strings.TrimSpace(string(body)); got != `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}` {
+			t.Errorf("Unexpected body %s", got)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"default","self":"http://www.example.com/jira/rest/api/2/group?groupname=default","users":{"size":1,"items":[],"max-results":50,"start-index":0,"end-index":0},"expand":"users"}`)
+	})
+
+	if group, _, err := testClient.Group.AddByAccountID("default", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	} else if group == nil {
+		t.Error("Expected group. Group is nil")
+	}
+}
+
+func TestGroupService_RemoveByAccountID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, "/rest/api/2/group/user?groupname=default&accountId=5b10ac8d82e05b22cc7d4ef5")
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := testClient.Group.RemoveByAccountID("default", "5b10ac8d82e05b22cc7d4ef5"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -55,6 +55,7 @@ type Issue struct {
 // ChangelogItems reflects one single changelog item of a history item
 type ChangelogItems struct {
 	Field      string      `json:"field" structs:"field"`
+	FieldID    string      `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
 	FieldType  string      `json:"fieldtype" structs:"fieldtype"`
 	From       interface{} `json:"from" structs:"from"`
 	FromString string      `json:"fromString" structs:"fromString"`
@@ -89,14 +90,19 @@ type Attachment struct {
 }
 
 // Epic represents the epic to which an issue is associated
-// Not that this struct does not process the returned "color" value
 type Epic struct {
-	ID      int    `json:"id" structs:"id"`
-	Key     string `json:"key" structs:"key"`
-	Self    string `json:"self" structs:"self"`
-	Name    string `json:"name" structs:"name"`
-	Summary string `json:"summary" structs:"summary"`
-	Done    bool   `json:"done" structs:"done"`
+	ID      int        `json:"id" structs:"id"`
+	Key     string     `json:"key" structs:"key"`
+	Self    string     `json:"self" structs:"self"`
+	Name    string     `json:"name" structs:"name"`
+	Summary string     `json:"summary" structs:"summary"`
+	Color   *EpicColor `json:"color,omitempty" structs:"color,omitempty"`
+	Done    bool       `json:"done" structs:"done"`
+}
+
+// EpicColor is the color of an epic on agile boards, e.g. "color_4"
+type EpicColor struct {
+	Key string `json:"key" structs:"key"`
 }
 
 // IssueFields represents single fields of a Jira issue.
@@ -309,9 +315,17 @@ type Transition struct {
 	Fields map[string]TransitionField `json:"fields" structs:"fields"`
 }
 
-// TransitionField represents the value of one Transition
+// TransitionField represents a field on the screen of a Transition.
+// AllowedValues holds the values of select-like fields, either objects with
+// an id and a name or value, or plain strings.
 type TransitionField struct {
-	Required bool `json:"required" structs:"required"`
+	Required        bool          `json:"required" structs:"required"`
+	Name            string        `json:"name,omitempty" structs:"name,omitempty"`
+	Key             string        `json:"key,omitempty" structs:"key,omitempty"`
+	Schema          FieldSchema   `json:"schema,omitempty" structs:"schema,omitempty"`
+	HasDefaultValue bool          `json:"hasDefaultValue,omitempty" structs:"hasDefaultValue,omitempty"`
+	Operations      []string      `json:"operations,omitempty" structs:"operations,omitempty"`
+	AllowedValues   []interface{} `json:"allowedValues,omitempty" structs:"allowedValues,omitempty"`
 }
 
 // CreateTransitionPayload is used for creating new issue transitions
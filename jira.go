@@ -43,6 +43,7 @@ type Client struct {
 	Project          *ProjectService
 	Board            *BoardService
 	Sprint           *SprintService
+	Epic             *EpicService
 	User             *UserService
 	Group            *GroupService
 	Version          *VersionService
@@ -58,9 +59,11 @@ type Client struct {
 	IssueLinkType    *IssueLinkTypeService
 
 	// Zephyr zapi services
-	Cycle     *CycleService
-	Folder    *FolderService
-	Execution *ExecutionService
+	Cycle      *CycleService
+	Folder     *FolderService
+	Execution  *ExecutionService
+	TestStep   *TestStepService
+	StepResult *StepResultService
 }
 
 // NewClient returns a new Jira API client.
@@ -94,6 +97,7 @@ func NewClient(httpClient httpClient, baseURL string) (*Client, error) {
 	c.Project = &ProjectService{client: c}
 	c.Board = &BoardService{client: c}
 	c.Sprint = &SprintService{client: c}
+	c.Epic = &EpicService{client: c}
 	c.User = &UserService{client: c}
 	c.Group = &GroupService{client: c}
 	c.Version = &VersionService{client: c}
@@ -110,6 +114,8 @@ func NewClient(httpClient httpClient, baseURL string) (*Client, error) {
 	c.Cycle = &CycleService{client: c}
 	c.Folder = &FolderService{client: c}
 	c.Execution = &ExecutionService{client: c}
+	c.TestStep = &TestStepService{client: c}
+	c.StepResult = &StepResultService{client: c}
 
 	return c, nil
 }
@@ -235,6 +241,12 @@ func addOptions(s string, opt interface{}) (string, error) {
 // A relative URL can be provided in urlStr, in which case it is resolved relative to the baseURL of the Client.
 // If specified, the value pointed to by buf is a multipart form.
 func (c *Client) NewMultiPartRequestWithContext(ctx context.Context, method, urlStr string, buf *bytes.Buffer) (*http.Request, error) {
+	return c.newMultiPartRequestWithContext(ctx, method, urlStr, buf)
+}
+
+// newMultiPartRequestWithContext is NewMultiPartRequestWithContext for any body.
+// A body that is not a *bytes.Buffer, e.g. the reading end of an io.Pipe, is streamed.
+func (c *Client) newMultiPartRequestWithContext(ctx context.Context, method, urlStr string, body io.Reader) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -244,7 +256,7 @@ func (c *Client) NewMultiPartRequestWithContext(ctx context.Context, method, url
 
 	u := c.baseURL.ResolveReference(rel)
 
-	req, err := newRequestWithContext(ctx, method, u.String(), buf)
+	req, err := newRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,141 @@
+package jiraconfig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jira "github.com/tya/go-jira"
+)
+
+func (pl *planner) filters(ctx context.Context, spec *Spec) error {
+	filters, _, err := pl.client.Filter.GetMyFiltersWithContext(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("jiraconfig: filters: %w", err)
+	}
+	current := make(map[string]*jira.Filter)
+	for _, f := range filters {
+		current[f.Name] = f
+		if id, err := strconv.Atoi(f.ID); err == nil {
+			pl.filterIDs[f.Name] = id
+		}
+	}
+
+	for _, fs := range spec.Filters {
+		fs := fs
+		f, ok := current[fs.Name]
+		switch {
+		case fs.Absent:
+			if ok {
+				id := pl.filterIDs[fs.Name]
+				pl.add(Change{Action: Delete, Kind: "filter", Name: fs.Name, apply: func(ctx context.Context) error {
+					_, err := pl.client.Filter.DeleteWithContext(ctx, id)
+					return err
+				}})
+			}
+		case !ok:
+			pl.add(Change{Action: Create, Kind: "filter", Name: fs.Name, apply: func(ctx context.Context) error {
+				created, _, err := pl.client.Filter.CreateWithContext(ctx, &jira.CreateFilterOptions{
+					Name:        fs.Name,
+					Description: fs.Description,
+					Jql:         fs.JQL,
+				})
+				if err != nil {
+					return err
+				}
+				if id, err := strconv.Atoi(created.ID); err == nil {
+					pl.filterIDs[fs.Name] = id
+				}
+				return nil
+			}})
+		default:
+			var d diff
+			d.str("description", f.Description, fs.Description)
+			d.str("jql", f.Jql, fs.JQL)
+			if len(d) > 0 {
+				id := pl.filterIDs[fs.Name]
+				pl.add(Change{Action: Update, Kind: "filter", Name: fs.Name, Diff: d, apply: func(ctx context.Context) error {
+					_, _, err := pl.client.Filter.UpdateWithContext(ctx, id, &jira.UpdateFilterOptions{
+						Name:        fs.Name,
+						Description: fs.Description,
+						Jql:         fs.JQL,
+					})
+					return err
+				}})
+			}
+		}
+	}
+	return nil
+}
+
+func (pl *planner) boards(ctx context.Context, spec *Spec) error {
+	for _, bs := range spec.Boards {
+		bs := bs
+		board, err := pl.board(ctx, bs.Name)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case bs.Absent:
+			if board != nil {
+				id := board.ID
+				pl.add(Change{Action: Delete, Kind: "board", Name: bs.Name, apply: func(ctx context.Context) error {
+					_, _, err := pl.client.Board.DeleteBoardWithContext(ctx, id)
+					return err
+				}})
+			}
+		case board == nil:
+			pl.add(Change{Action: Create, Kind: "board", Name: bs.Name, apply: func(ctx context.Context) error {
+				filterID, ok := pl.filterIDs[bs.Filter]
+				if !ok {
+					return fmt.Errorf("filter %q does not exist", bs.Filter)
+				}
+				options := &jira.CreateBoardWithFilterOptions{Name: bs.Name, Type: bs.Type, FilterID: filterID}
+				if bs.Project != "" {
+					options.Location = &jira.BoardLocation{Type: "project", ProjectKeyOrID: bs.Project}
+				}
+				_, _, err := pl.client.Board.CreateBoardWithFilterWithContext(ctx, options)
+				return err
+			}})
+		default:
+			if board.Type != bs.Type {
+				return fmt.Errorf("jiraconfig: board %q is of type %q and cannot be changed to %q", bs.Name, board.Type, bs.Type)
+			}
+			config, _, err := pl.client.Board.GetBoardConfigurationWithContext(ctx, board.ID)
+			if err != nil {
+				return fmt.Errorf("jiraconfig: board %q: %w", bs.Name, err)
+			}
+			if id, ok := pl.filterIDs[bs.Filter]; !ok || strconv.Itoa(id) != config.Filter.ID {
+				return fmt.Errorf("jiraconfig: board %q uses the filter %s and cannot be changed to %q", bs.Name, config.Filter.ID, bs.Filter)
+			}
+		}
+	}
+	return nil
+}
+
+// board returns the board called name, nil if there is none
+func (pl *planner) board(ctx context.Context, name string) (*jira.Board, error) {
+	var found *jira.Board
+	opts := &jira.BoardListOptions{Name: name, SearchOptions: jira.SearchOptions{MaxResults: 50}}
+	for {
+		page, _, err := pl.client.Board.GetAllBoardsWithContext(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("jiraconfig: board %q: %w", name, err)
+		}
+		// The name option also matches boards whose name contains it
+		for i := range page.Values {
+			if page.Values[i].Name != name {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("jiraconfig: there are several boards called %q", name)
+			}
+			found = &page.Values[i]
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			return found, nil
+		}
+		opts.StartAt += len(page.Values)
+	}
+}
@@ -0,0 +1,130 @@
+package jiraconfig
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/tya/go-jira"
+)
+
+func (pl *planner) issueLinkTypes(ctx context.Context, spec *Spec) error {
+	if len(spec.IssueLinkTypes) == 0 {
+		return nil
+	}
+	linkTypes, _, err := pl.client.IssueLinkType.GetListWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("jiraconfig: issue link types: %w", err)
+	}
+	current := make(map[string]jira.IssueLinkType)
+	for _, l := range linkTypes {
+		current[l.Name] = l
+	}
+
+	for _, ls := range spec.IssueLinkTypes {
+		ls := ls
+		l, ok := current[ls.Name]
+		switch {
+		case ls.Absent:
+			if ok {
+				pl.add(Change{Action: Delete, Kind: "issue link type", Name: ls.Name, apply: func(ctx context.Context) error {
+					_, err := pl.client.IssueLinkType.DeleteWithContext(ctx, l.ID)
+					return err
+				}})
+			}
+		case !ok:
+			pl.add(Change{Action: Create, Kind: "issue link type", Name: ls.Name, apply: func(ctx context.Context) error {
+				_, _, err := pl.client.IssueLinkType.CreateWithContext(ctx, &jira.IssueLinkType{Name: ls.Name, Inward: ls.Inward, Outward: ls.Outward})
+				return err
+			}})
+		default:
+			var d diff
+			d.str("inward", l.Inward, ls.Inward)
+			d.str("outward", l.Outward, ls.Outward)
+			if len(d) > 0 {
+				pl.add(Change{Action: Update, Kind: "issue link type", Name: ls.Name, Diff: d, apply: func(ctx context.Context) error {
+					_, _, err := pl.client.IssueLinkType.UpdateWithContext(ctx, &jira.IssueLinkType{ID: l.ID, Name: ls.Name, Inward: ls.Inward, Outward: ls.Outward})
+					return err
+				}})
+			}
+		}
+	}
+	return nil
+}
+
+func (pl *planner) groups(ctx context.Context, spec *Spec) error {
+	for _, gs := range spec.Groups {
+		gs := gs
+		members, err := pl.groupMembers(ctx, gs.Name)
+		if err != nil {
+			return err
+		}
+		names := make(map[string]bool)
+		accountIDs := make(map[string]bool)
+		for _, m := range members {
+			names[m.Name] = m.Name != ""
+			accountIDs[m.AccountID] = m.AccountID != ""
+		}
+
+		specified := make(map[string]bool)
+		for _, m := range gs.Members {
+			m := m
+			specified[m] = true
+			if names[m] {
+				continue
+			}
+			pl.add(Change{Action: Create, Kind: "group member", Name: gs.Name + "/" + m, apply: func(ctx context.Context) error {
+				_, _, err := pl.client.Group.AddWithContext(ctx, gs.Name, m)
+				return err
+			}})
+		}
+		specifiedIDs := make(map[string]bool)
+		for _, id := range gs.AccountIDs {
+			id := id
+			specifiedIDs[id] = true
+			if accountIDs[id] {
+				continue
+			}
+			pl.add(Change{Action: Create, Kind: "group member", Name: gs.Name + "/" + id, apply: func(ctx context.Context) error {
+				_, _, err := pl.client.Group.AddByAccountIDWithContext(ctx, gs.Name, id)
+				return err
+			}})
+		}
+		if !gs.Prune {
+			continue
+		}
+		for _, m := range members {
+			m := m
+			switch {
+			case (m.Name != "" && specified[m.Name]) || (m.AccountID != "" && specifiedIDs[m.AccountID]):
+			case m.AccountID != "":
+				pl.add(Change{Action: Delete, Kind: "group member", Name: gs.Name + "/" + m.AccountID, apply: func(ctx context.Context) error {
+					_, err := pl.client.Group.RemoveByAccountIDWithContext(ctx, gs.Name, m.AccountID)
+					return err
+				}})
+			default:
+				pl.add(Change{Action: Delete, Kind: "group member", Name: gs.Name + "/" + m.Name, apply: func(ctx context.Context) error {
+					_, err := pl.client.Group.RemoveWithContext(ctx, gs.Name, m.Name)
+					return err
+				}})
+			}
+		}
+	}
+	return nil
+}
+
+// groupMembers returns all members of the group called name
+func (pl *planner) groupMembers(ctx context.Context, name string) ([]jira.GroupMember, error) {
+	var members []jira.GroupMember
+	opts := &jira.GroupSearchOptions{MaxResults: 50}
+	for {
+		page, resp, err := pl.client.Group.GetWithOptionsWithContext(ctx, name, opts)
+		if err != nil {
+			return nil, fmt.Errorf("jiraconfig: members of group %q: %w", name, err)
+		}
+		members = append(members, page...)
+		if len(page) == 0 || resp.StartAt+len(page) >= resp.Total {
+			return members, nil
+		}
+		opts.StartAt += len(page)
+	}
+}
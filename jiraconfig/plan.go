@@ -0,0 +1,165 @@
+package jiraconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	jira "github.com/tya/go-jira"
+)
+
+// Action is what a change does to an entry
+type Action string
+
+// The actions of a change
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+var actionSigns = map[Action]string{Create: "+", Update: "~", Delete: "-"}
+
+// Change is a single change of a plan
+type Change struct {
+	Action Action
+	// Kind is the kind of the entry, e.g. "project" or "group member"
+	Kind string
+	// Name identifies the entry, e.g. "PAY" for a project and "PAY/Backend" for one of its components
+	Name string
+	// Diff lists the changed attributes of an update, e.g. `jql: "project = PAY" -> "project = PAY AND type = Bug"`
+	Diff []string
+
+	apply func(ctx context.Context) error
+}
+
+// String returns the change as a line of the plan, e.g. `+ filter "Payments"`
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s %q", actionSigns[c.Action], c.Kind, c.Name)
+}
+
+// Plan is the ordered list of changes that make Jira match a spec
+type Plan struct {
+	Changes []Change
+
+	client *jira.Client
+	// projectIDs and filterIDs cache the IDs of projects by key and of
+	// filters by name, also of those created by the plan
+	projectIDs map[string]int
+	filterIDs  map[string]int
+}
+
+// Empty reports whether Jira already matches the spec
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// WriteTo writes the plan to w, a line per change followed by the lines of its diff
+func (p *Plan) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	if p.Empty() {
+		buf.WriteString("No changes.\n")
+	}
+	for i := range p.Changes {
+		c := &p.Changes[i]
+		fmt.Fprintln(&buf, c.String())
+		for _, d := range c.Diff {
+			fmt.Fprintf(&buf, "    %s\n", d)
+		}
+	}
+	return buf.WriteTo(w)
+}
+
+// String returns the plan as written by WriteTo
+func (p *Plan) String() string {
+	var buf bytes.Buffer
+	p.WriteTo(&buf)
+	return buf.String()
+}
+
+// Apply wraps ApplyWithContext using the background context
+func (p *Plan) Apply() error {
+	return p.ApplyWithContext(context.Background())
+}
+
+// ApplyWithContext makes the changes of the plan in order. It stops at the
+// first change that fails; computing the plan again resumes from there.
+func (p *Plan) ApplyWithContext(ctx context.Context) error {
+	for i := range p.Changes {
+		c := &p.Changes[i]
+		if err := c.apply(ctx); err != nil {
+			return fmt.Errorf("jiraconfig: %s %s %q: %w", c.Action, c.Kind, c.Name, err)
+		}
+	}
+	return nil
+}
+
+// Compute wraps ComputeWithContext using the background context
+func Compute(client *jira.Client, spec *Spec) (*Plan, error) {
+	return ComputeWithContext(context.Background(), client, spec)
+}
+
+// ComputeWithContext reads the current state of the entries of spec and
+// returns the plan that makes Jira match it. Filters are created before the
+// boards using them and projects before their components and versions;
+// deletes come last, boards before filters.
+func ComputeWithContext(ctx context.Context, client *jira.Client, spec *Spec) (*Plan, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	pl := &planner{Plan: &Plan{client: client, projectIDs: make(map[string]int), filterIDs: make(map[string]int)}}
+	steps := []func(context.Context, *Spec) error{
+		pl.filters,
+		pl.projects,
+		pl.issueLinkTypes,
+		pl.groups,
+		pl.boards,
+	}
+	for _, step := range steps {
+		if err := step(ctx, spec); err != nil {
+			return nil, err
+		}
+	}
+	for i := len(pl.deletes) - 1; i >= 0; i-- {
+		pl.Changes = append(pl.Changes, pl.deletes[i])
+	}
+	return pl.Plan, nil
+}
+
+// planner collects the changes of a plan. Deletes are collected apart, to
+// be made in reverse order after all other changes.
+type planner struct {
+	*Plan
+	deletes []Change
+}
+
+func (pl *planner) add(c Change) {
+	if c.Action == Delete {
+		pl.deletes = append(pl.deletes, c)
+		return
+	}
+	pl.Changes = append(pl.Changes, c)
+}
+
+// diff collects the differences of the attributes of an entry
+type diff []string
+
+// str adds the attribute name if want is set and differs from got
+func (d *diff) str(name, got, want string) {
+	if want != "" && got != want {
+		*d = append(*d, fmt.Sprintf("%s: %q -> %q", name, got, want))
+	}
+}
+
+// flag adds the attribute name if want is set and got is not
+func (d *diff) flag(name string, got, want bool) {
+	if want && !got {
+		*d = append(*d, fmt.Sprintf("%s: false -> true", name))
+	}
+}
+
+// notFound reports whether resp is the reply to a request for a missing entry
+func notFound(resp *jira.Response) bool {
+	return resp != nil && resp.StatusCode == 404
+}
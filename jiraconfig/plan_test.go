@@ -0,0 +1,450 @@
+package jiraconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	jira "github.com/tya/go-jira"
+)
+
+// fakeJira keeps the state of the entries a plan reads and changes, so that
+// a plan can be applied and computed again
+type fakeJira struct {
+	t      *testing.T
+	nextID int
+
+	projects   []jira.Project
+	components []jira.ProjectComponent
+	versions   []jira.Version
+	filters    []jira.Filter
+	boards     []jira.Board
+	linkTypes  []jira.IssueLinkType
+	groups     map[string][]jira.GroupMember
+}
+
+// newJiraServer fakes a Jira with the project PAY, its components Backend and
+// Legacy and its version 1.0, the filters "PAY open" and Obsolete, the board
+// "Old board" on Obsolete, the link type Blocks and the group payments-team
+// of alice and bob
+func newJiraServer(t *testing.T) (*jira.Client, *fakeJira, func()) {
+	f := &fakeJira{
+		t:      t,
+		nextID: 20000,
+		projects: []jira.Project{
+			{ID: "10000", Key: "PAY", Name: "Payments", Description: "Payments", Lead: jira.User{Name: "alice"}},
+		},
+		components: []jira.ProjectComponent{
+			{ID: "10100", Name: "Backend", Description: "API", Project: "PAY", ProjectID: 10000},
+			{ID: "10101", Name: "Legacy", Project: "PAY", ProjectID: 10000},
+		},
+		versions: []jira.Version{{ID: "10200", Name: "1.0", ProjectID: 10000, ReleaseDate: "2020-04-10"}},
+		filters: []jira.Filter{
+			{ID: "10300", Name: "PAY open", Jql: "project = PAY"},
+			{ID: "10301", Name: "Obsolete", Jql: "project = OLD"},
+		},
+		boards:    []jira.Board{{ID: 7, Name: "Old board", Type: "kanban", FilterID: 10301}},
+		linkTypes: []jira.IssueLinkType{{ID: "10400", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}},
+		groups: map[string][]jira.GroupMember{
+			"payments-team": {{Name: "alice"}, {Name: "bob"}},
+		},
+	}
+	server := httptest.NewServer(f)
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, f, server.Close
+}
+
+func (f *fakeJira) id() string {
+	f.nextID++
+	return strconv.Itoa(f.nextID)
+}
+
+func (f *fakeJira) decode(r *http.Request, v interface{}) {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		f.t.Errorf("%s %s: %v", r.Method, r.URL.Path, err)
+	}
+}
+
+func (f *fakeJira) reply(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func page(values interface{}) map[string]interface{} {
+	return map[string]interface{}{"startAt": 0, "maxResults": 50, "isLast": true, "values": values}
+}
+
+func (f *fakeJira) project(key string) *jira.Project {
+	for i := range f.projects {
+		if f.projects[i].Key == key || f.projects[i].ID == key {
+			return &f.projects[i]
+		}
+	}
+	return nil
+}
+
+func (f *fakeJira) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	last := path[len(path)-1]
+	// is matches the request against a method and a path, * matching any segment
+	is := func(method, pattern string) bool {
+		parts := strings.Split(pattern, "/")
+		if r.Method != method || len(parts) != len(path) {
+			return false
+		}
+		for i := range parts {
+			if parts[i] != "*" && parts[i] != path[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case is("GET", "rest/api/2/project/*/component"):
+		var cs []jira.ProjectComponent
+		for _, c := range f.components {
+			if c.Project == path[4] {
+				cs = append(cs, c)
+			}
+		}
+		f.reply(w, 200, page(cs))
+	case is("GET", "rest/api/2/project/*/version"):
+		var vs []jira.Version
+		for _, v := range f.versions {
+			if strconv.Itoa(v.ProjectID) == f.project(path[4]).ID {
+				vs = append(vs, v)
+			}
+		}
+		f.reply(w, 200, page(vs))
+	case is("GET", "rest/api/2/project/*"):
+		if p := f.project(last); p != nil {
+			f.reply(w, 200, p)
+			return
+		}
+		f.reply(w, 404, map[string]interface{}{"errorMessages": []string{"No project could be found with key '" + last + "'."}})
+	case is("POST", "rest/api/2/project"):
+		var opts jira.CreateProjectOptions
+		f.decode(r, &opts)
+		p := jira.Project{ID: f.id(), Key: opts.Key, Name: opts.Name, Description: opts.Description, Lead: jira.User{Name: opts.Lead}}
+		f.projects = append(f.projects, p)
+		f.reply(w, 201, map[string]interface{}{"id": json.Number(p.ID), "key": p.Key})
+	case is("PUT", "rest/api/2/project/*"):
+		var opts jira.UpdateProjectOptions
+		f.decode(r, &opts)
+		p := f.project(last)
+		p.Description = opts.Description
+		f.reply(w, 200, p)
+	case is("POST", "rest/api/2/component"):
+		var opts jira.CreateComponentOptions
+		f.decode(r, &opts)
+		p := f.project(opts.Project)
+		projectID, _ := strconv.Atoi(p.ID)
+		c := jira.ProjectComponent{ID: f.id(), Name: opts.Name, Description: opts.Description, Lead: jira.User{Name: opts.LeadUserName}, Project: p.Key, ProjectID: projectID}
+		f.components = append(f.components, c)
+		f.reply(w, 201, c)
+	case is("PUT", "rest/api/2/component/*"):
+		var opts jira.UpdateComponentOptions
+		f.decode(r, &opts)
+		for i := range f.components {
+			if f.components[i].ID == last {
+				f.components[i].Description = opts.Description
+				f.reply(w, 200, f.components[i])
+			}
+		}
+	case is("DELETE", "rest/api/2/component/*"):
+		for i := range f.components {
+			if f.components[i].ID == last {
+				f.components = append(f.components[:i], f.components[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(204)
+	case is("POST", "rest/api/2/version"):
+		var v jira.Version
+		f.decode(r, &v)
+		v.ID = f.id()
+		f.versions = append(f.versions, v)
+		f.reply(w, 201, v)
+	case is("PUT", "rest/api/2/version/*"):
+		var v jira.Version
+		f.decode(r, &v)
+		for i := range f.versions {
+			if f.versions[i].ID == last {
+				f.versions[i].Released = f.versions[i].Released || v.Released
+				f.versions[i].Archived = f.versions[i].Archived || v.Archived
+				f.reply(w, 200, f.versions[i])
+			}
+		}
+	case is("GET", "rest/api/3/filter/my"):
+		f.reply(w, 200, f.filters)
+	case is("POST", "rest/api/2/filter"):
+		var opts jira.CreateFilterOptions
+		f.decode(r, &opts)
+		filter := jira.Filter{ID: f.id(), Name: opts.Name, Description: opts.Description, Jql: opts.Jql}
+		f.filters = append(f.filters, filter)
+		f.reply(w, 200, filter)
+	case is("PUT", "rest/api/2/filter/*"):
+		var opts jira.UpdateFilterOptions
+		f.decode(r, &opts)
+		for i := range f.filters {
+			if f.filters[i].ID == last {
+				f.filters[i].Jql = opts.Jql
+				f.reply(w, 200, f.filters[i])
+			}
+		}
+	case is("DELETE", "rest/api/2/filter/*"):
+		for i := range f.filters {
+			if f.filters[i].ID == last {
+				f.filters = append(f.filters[:i], f.filters[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(204)
+	case is("GET", "rest/agile/1.0/board/*/configuration"):
+		for _, b := range f.boards {
+			if strconv.Itoa(b.ID) == path[4] {
+				f.reply(w, 200, map[string]interface{}{"id": b.ID, "name": b.Name, "filter": map[string]string{"id": strconv.Itoa(b.FilterID)}})
+			}
+		}
+	case is("GET", "rest/agile/1.0/board"):
+		var bs []jira.Board
+		for _, b := range f.boards {
+			if strings.Contains(b.Name, r.URL.Query().Get("name")) {
+				bs = append(bs, b)
+			}
+		}
+		f.reply(w, 200, page(bs))
+	case is("POST", "rest/agile/1.0/board"):
+		var b jira.Board
+		f.decode(r, &b)
+		if b.Location == nil || b.Location.ProjectKeyOrID != "PAY" {
+			f.t.Errorf("Expected the board %q in PAY, got %+v", b.Name, b.Location)
+		}
+		b.ID = f.nextID
+		f.nextID++
+		f.boards = append(f.boards, b)
+		f.reply(w, 201, b)
+	case is("DELETE", "rest/agile/1.0/board/*"):
+		for i := range f.boards {
+			if strconv.Itoa(f.boards[i].ID) == last {
+				f.boards = append(f.boards[:i], f.boards[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(204)
+	case is("GET", "rest/api/2/issueLinkType"):
+		f.reply(w, 200, f.linkTypes)
+	case is("POST", "rest/api/2/issueLinkType"):
+		var l jira.IssueLinkType
+		f.decode(r, &l)
+		l.ID = f.id()
+		f.linkTypes = append(f.linkTypes, l)
+		f.reply(w, 201, l)
+	case is("PUT", "rest/api/2/issueLinkType/*"):
+		var l jira.IssueLinkType
+		f.decode(r, &l)
+		for i := range f.linkTypes {
+			if f.linkTypes[i].ID == last {
+				f.linkTypes[i] = l
+			}
+		}
+		f.reply(w, 200, l)
+	case is("GET", "rest/api/2/group/member"):
+		members := f.groups[r.URL.Query().Get("groupname")]
+		f.reply(w, 200, map[string]interface{}{"startAt": 0, "maxResults": 50, "total": len(members), "values": members})
+	case is("POST", "rest/api/2/group/user"):
+		var user jira.GroupMember
+		f.decode(r, &user)
+		name := r.URL.Query().Get("groupname")
+		f.groups[name] = append(f.groups[name], user)
+		f.reply(w, 201, map[string]string{"name": name})
+	case is("DELETE", "rest/api/2/group/user"):
+		q := r.URL.Query()
+		name, username, accountID := q.Get("groupname"), q.Get("username"), q.Get("accountId")
+		members := f.groups[name]
+		for i := range members {
+			if (username != "" && members[i].Name == username) || (accountID != "" && members[i].AccountID == accountID) {
+				f.groups[name] = append(members[:i], members[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(200)
+	default:
+		f.t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(500)
+	}
+}
+
+func loadSpec(t *testing.T) *Spec {
+	file, err := os.Open("testdata/spec.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	spec, err := Load(file)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	return spec
+}
+
+func TestComputeAndApply(t *testing.T) {
+	client, fake, teardown := newJiraServer(t)
+	defer teardown()
+
+	plan, err := Compute(client, loadSpec(t))
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	want := `~ filter "PAY open"
+    jql: "project = PAY" -> "project = PAY AND resolution is EMPTY"
++ filter "PAY bugs"
+~ project "PAY"
+    description: "Payments" -> "Payment processing"
+~ component "PAY/Backend"
+    description: "API" -> "Payment API"
++ component "PAY/Frontend"
+~ version "PAY/1.0"
+    released: false -> true
++ version "PAY/1.1"
++ project "NEW"
++ component "NEW/Core"
++ version "NEW/0.1"
+~ issue link type "Blocks"
+    outward: "blocks" -> "is blocking"
++ issue link type "Duplicate"
++ group member "payments-team/carol"
++ board "PAY board"
+- board "Old board"
+- group member "payments-team/bob"
+- component "PAY/Legacy"
+- filter "Obsolete"
+`
+	if got := plan.String(); got != want {
+		t.Errorf("Unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(fake.boards) != 1 || fake.boards[0].Name != "PAY board" || strconv.Itoa(fake.boards[0].FilterID) != fake.filters[1].ID {
+		t.Errorf("Expected the board PAY board on the filter PAY bugs, got %+v", fake.boards)
+	}
+	for _, v := range fake.versions {
+		if v.Name == "0.1" && strconv.Itoa(v.ProjectID) != fake.project("NEW").ID {
+			t.Errorf("Expected the version 0.1 in project NEW, got %+v", v)
+		}
+	}
+
+	plan, err = Compute(client, loadSpec(t))
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("Expected no changes after apply, got:\n%s", plan)
+	}
+}
+
+func TestComputeAndApply_groupAccountIDs(t *testing.T) {
+	client, fake, teardown := newJiraServer(t)
+	defer teardown()
+	fake.groups["cloud-team"] = []jira.GroupMember{{AccountID: "5b10a"}, {AccountID: "5b10b"}}
+
+	spec := &Spec{Groups: []GroupSpec{{Name: "cloud-team", AccountIDs: []string{"5b10a", "5b10c"}, Prune: true}}}
+	plan, err := Compute(client, spec)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	want := "+ group member \"cloud-team/5b10c\"\n- group member \"cloud-team/5b10b\"\n"
+	if got := plan.String(); got != want {
+		t.Errorf("Unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	want2 := []jira.GroupMember{{AccountID: "5b10a"}, {AccountID: "5b10c"}}
+	if !reflect.DeepEqual(fake.groups["cloud-team"], want2) {
+		t.Errorf("Expected members %v, got %v", want2, fake.groups["cloud-team"])
+	}
+}
+
+func TestCompute_boardChanged(t *testing.T) {
+	client, _, teardown := newJiraServer(t)
+	defer teardown()
+
+	spec := &Spec{Boards: []BoardSpec{{Name: "Old board", Type: "scrum", Filter: "Obsolete"}}}
+	if _, err := Compute(client, spec); err == nil || !strings.Contains(err.Error(), `cannot be changed to "scrum"`) {
+		t.Errorf("Expected the board type change to be refused, got %v", err)
+	}
+	spec.Boards[0] = BoardSpec{Name: "Old board", Type: "kanban", Filter: "PAY open"}
+	if _, err := Compute(client, spec); err == nil || !strings.Contains(err.Error(), `cannot be changed to "PAY open"`) {
+		t.Errorf("Expected the board filter change to be refused, got %v", err)
+	}
+	spec.Boards[0].Filter = "Obsolete"
+	if plan, err := Compute(client, spec); err != nil || !plan.Empty() {
+		t.Errorf("Expected no changes, got %v, %v", plan, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	for _, tc := range []struct {
+		spec, err string
+	}{
+		{`{"projects": [{"key": "PAY", "name": "Payments", "components": [{"nme": "Backend"}]}]}`, `unknown field "nme"`},
+		{`{"filters": [{"name": "Bugs", "jql": "type = Bug"}, {"name": "Bugs", "jql": "type = Bug"}]}`, `filter "Bugs" is specified twice`},
+		{`{"filters": [{"name": "Bugs", "absent": true}], "boards": [{"name": "Bugs", "type": "kanban", "filter": "Bugs"}]}`, `uses the absent filter "Bugs"`},
+		{`{"boards": [{"name": "Bugs", "type": "board", "filter": "Bugs"}]}`, `want scrum or kanban`},
+	} {
+		_, err := Load(strings.NewReader(tc.spec))
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("Load(%s): expected an error containing %q, got %v", tc.spec, tc.err, err)
+		}
+	}
+}
+
+func TestLoad_yaml(t *testing.T) {
+	load := func(path string) *Spec {
+		file, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		spec, err := Load(file)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		return spec
+	}
+	if yamlSpec, jsonSpec := load("testdata/spec.yaml"), load("testdata/spec.json"); !reflect.DeepEqual(yamlSpec, jsonSpec) {
+		t.Errorf("Expected the YAML spec to equal the JSON one, got\n%+v\nwant\n%+v", yamlSpec, jsonSpec)
+	}
+
+	if _, err := Load(strings.NewReader("filters:\n  - name: Bugs\n    jq: type = Bug\n")); err == nil || !strings.Contains(err.Error(), "field jq not found") {
+		t.Errorf("Expected an unknown field, got %v", err)
+	}
+}
+
+func ExamplePlan_WriteTo() {
+	plan := &Plan{Changes: []Change{
+		{Action: Update, Kind: "filter", Name: "PAY open", Diff: []string{`jql: "project = PAY" -> "project = PAY AND resolution is EMPTY"`}},
+		{Action: Delete, Kind: "board", Name: "Old board"},
+	}}
+	plan.WriteTo(os.Stdout)
+	fmt.Println(plan.Empty())
+	// Output:
+	// ~ filter "PAY open"
+	//     jql: "project = PAY" -> "project = PAY AND resolution is EMPTY"
+	// - board "Old board"
+	// false
+}
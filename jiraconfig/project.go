@@ -0,0 +1,255 @@
+package jiraconfig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jira "github.com/tya/go-jira"
+)
+
+func (pl *planner) projects(ctx context.Context, spec *Spec) error {
+	for i := range spec.Projects {
+		ps := spec.Projects[i]
+		project, resp, err := pl.client.Project.GetWithContext(ctx, ps.Key)
+		if err != nil && !notFound(resp) {
+			return fmt.Errorf("jiraconfig: project %q: %w", ps.Key, err)
+		}
+
+		switch {
+		case ps.Absent:
+			if project != nil {
+				pl.add(Change{Action: Delete, Kind: "project", Name: ps.Key, apply: func(ctx context.Context) error {
+					_, err := pl.client.Project.DeleteWithContext(ctx, ps.Key)
+					return err
+				}})
+			}
+			continue
+		case project == nil:
+			pl.add(Change{Action: Create, Kind: "project", Name: ps.Key, apply: func(ctx context.Context) error {
+				created, _, err := pl.client.Project.CreateWithContext(ctx, &jira.CreateProjectOptions{
+					Key:                ps.Key,
+					Name:               ps.Name,
+					ProjectTypeKey:     ps.ProjectTypeKey,
+					ProjectTemplateKey: ps.Template,
+					Description:        ps.Description,
+					Lead:               ps.Lead,
+					LeadAccountID:      ps.LeadAccountID,
+				})
+				if err != nil {
+					return err
+				}
+				if id, err := strconv.Atoi(created.ID); err == nil {
+					pl.projectIDs[ps.Key] = id
+				}
+				return nil
+			}})
+		default:
+			var d diff
+			d.str("name", project.Name, ps.Name)
+			d.str("description", project.Description, ps.Description)
+			d.str("lead", project.Lead.Name, ps.Lead)
+			d.str("leadAccountId", project.Lead.AccountID, ps.LeadAccountID)
+			if len(d) > 0 {
+				pl.add(Change{Action: Update, Kind: "project", Name: ps.Key, Diff: d, apply: func(ctx context.Context) error {
+					_, _, err := pl.client.Project.UpdateWithContext(ctx, ps.Key, &jira.UpdateProjectOptions{
+						Name:          ps.Name,
+						Description:   ps.Description,
+						Lead:          ps.Lead,
+						LeadAccountID: ps.LeadAccountID,
+					})
+					return err
+				}})
+			}
+		}
+
+		if err := pl.components(ctx, &ps, project != nil); err != nil {
+			return err
+		}
+		if err := pl.versions(ctx, &ps, project != nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectID returns the ID of the project identified by key, which versions are created with
+func (p *Plan) projectID(ctx context.Context, key string) (int, error) {
+	if id, ok := p.projectIDs[key]; ok {
+		return id, nil
+	}
+	project, _, err := p.client.Project.GetWithContext(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.Atoi(project.ID)
+	if err != nil {
+		return 0, fmt.Errorf("project %q has no numeric id: %w", key, err)
+	}
+	p.projectIDs[key] = id
+	return id, nil
+}
+
+func (pl *planner) components(ctx context.Context, ps *ProjectSpec, exists bool) error {
+	current := make(map[string]jira.ProjectComponent)
+	var order []string
+	opts := &jira.ComponentListOptions{MaxResults: 50}
+	for exists {
+		page, _, err := pl.client.Component.GetListWithContext(ctx, ps.Key, opts)
+		if err != nil {
+			return fmt.Errorf("jiraconfig: components of project %q: %w", ps.Key, err)
+		}
+		for _, c := range page.Values {
+			current[c.Name] = c
+			order = append(order, c.Name)
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		opts.StartAt += len(page.Values)
+	}
+
+	specified := make(map[string]bool)
+	for _, cs := range ps.Components {
+		cs := cs
+		name := ps.Key + "/" + cs.Name
+		specified[cs.Name] = true
+		c, ok := current[cs.Name]
+		switch {
+		case cs.Absent:
+			if ok {
+				pl.deleteComponent(name, c.ID)
+			}
+		case !ok:
+			pl.add(Change{Action: Create, Kind: "component", Name: name, apply: func(ctx context.Context) error {
+				_, _, err := pl.client.Component.CreateWithContext(ctx, &jira.CreateComponentOptions{
+					Name:         cs.Name,
+					Description:  cs.Description,
+					LeadUserName: cs.Lead,
+					Project:      ps.Key,
+				})
+				return err
+			}})
+		default:
+			var d diff
+			d.str("description", c.Description, cs.Description)
+			d.str("lead", c.Lead.Name, cs.Lead)
+			if len(d) > 0 {
+				id := c.ID
+				pl.add(Change{Action: Update, Kind: "component", Name: name, Diff: d, apply: func(ctx context.Context) error {
+					_, _, err := pl.client.Component.UpdateWithContext(ctx, id, &jira.UpdateComponentOptions{
+						Description:  cs.Description,
+						LeadUserName: cs.Lead,
+					})
+					return err
+				}})
+			}
+		}
+	}
+	if ps.Prune {
+		for _, n := range order {
+			if !specified[n] {
+				pl.deleteComponent(ps.Key+"/"+n, current[n].ID)
+			}
+		}
+	}
+	return nil
+}
+
+func (pl *planner) deleteComponent(name, id string) {
+	pl.add(Change{Action: Delete, Kind: "component", Name: name, apply: func(ctx context.Context) error {
+		_, err := pl.client.Component.DeleteWithContext(ctx, id, "")
+		return err
+	}})
+}
+
+func (pl *planner) versions(ctx context.Context, ps *ProjectSpec, exists bool) error {
+	current := make(map[string]jira.Version)
+	var order []string
+	opts := &jira.VersionListOptions{MaxResults: 50}
+	for exists {
+		page, _, err := pl.client.Version.GetListWithContext(ctx, ps.Key, opts)
+		if err != nil {
+			return fmt.Errorf("jiraconfig: versions of project %q: %w", ps.Key, err)
+		}
+		for _, v := range page.Values {
+			current[v.Name] = v
+			order = append(order, v.Name)
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		opts.StartAt += len(page.Values)
+	}
+
+	specified := make(map[string]bool)
+	for _, vs := range ps.Versions {
+		vs := vs
+		name := ps.Key + "/" + vs.Name
+		specified[vs.Name] = true
+		v, ok := current[vs.Name]
+		switch {
+		case vs.Absent:
+			if ok {
+				pl.deleteVersion(name, v.ID)
+			}
+		case !ok:
+			pl.add(Change{Action: Create, Kind: "version", Name: name, apply: func(ctx context.Context) error {
+				projectID, err := pl.projectID(ctx, ps.Key)
+				if err != nil {
+					return err
+				}
+				_, _, err = pl.client.Version.CreateWithContext(ctx, &jira.Version{
+					Name:        vs.Name,
+					Description: vs.Description,
+					StartDate:   vs.StartDate,
+					ReleaseDate: vs.ReleaseDate,
+					Released:    vs.Released,
+					Archived:    vs.Archived,
+					ProjectID:   projectID,
+				})
+				return err
+			}})
+		default:
+			var d diff
+			d.str("description", v.Description, vs.Description)
+			d.str("startDate", v.StartDate, vs.StartDate)
+			d.str("releaseDate", v.ReleaseDate, vs.ReleaseDate)
+			d.flag("released", v.Released, vs.Released)
+			d.flag("archived", v.Archived, vs.Archived)
+			if len(d) > 0 {
+				id := v.ID
+				pl.add(Change{Action: Update, Kind: "version", Name: name, Diff: d, apply: func(ctx context.Context) error {
+					_, _, err := pl.client.Version.UpdateWithContext(ctx, &jira.Version{
+						ID:          id,
+						Description: vs.Description,
+						StartDate:   vs.StartDate,
+						ReleaseDate: vs.ReleaseDate,
+						Released:    vs.Released,
+						Archived:    vs.Archived,
+					})
+					return err
+				}})
+			}
+		}
+	}
+	if ps.Prune {
+		for _, n := range order {
+			if !specified[n] {
+				pl.deleteVersion(ps.Key+"/"+n, current[n].ID)
+			}
+		}
+	}
+	return nil
+}
+
+func (pl *planner) deleteVersion(name, id string) {
+	pl.add(Change{Action: Delete, Kind: "version", Name: name, apply: func(ctx context.Context) error {
+		versionID, err := strconv.Atoi(id)
+		if err != nil {
+			return fmt.Errorf("version %q has no numeric id: %w", name, err)
+		}
+		_, err = pl.client.Version.DeleteWithContext(ctx, versionID, nil)
+		return err
+	}})
+}
@@ -0,0 +1,208 @@
+// Package jiraconfig manages Jira configuration as code. A Spec describes the
+// projects with their components and versions, the filters, boards, issue
+// link types and group memberships that should exist. Compute reads the
+// current state through the services of a jira.Client and returns the Plan of
+// the creates, updates and deletes that make Jira match the Spec, which can be
+// printed for review and applied. Applying a plan and computing it again
+// yields an empty plan.
+//
+// Entries missing from a Spec are left alone, unless their parent prunes
+// them, e.g. the components of a project with Prune set. Entries are deleted
+// when they are marked Absent. Attributes left empty in a spec are not changed.
+package jiraconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Spec is the desired configuration of Jira, as decoded by Load from YAML or JSON
+type Spec struct {
+	Projects       []ProjectSpec       `json:"projects,omitempty" yaml:"projects,omitempty"`
+	Filters        []FilterSpec        `json:"filters,omitempty" yaml:"filters,omitempty"`
+	Boards         []BoardSpec         `json:"boards,omitempty" yaml:"boards,omitempty"`
+	IssueLinkTypes []IssueLinkTypeSpec `json:"issueLinkTypes,omitempty" yaml:"issueLinkTypes,omitempty"`
+	Groups         []GroupSpec         `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+// ProjectSpec describes a project, identified by its key
+type ProjectSpec struct {
+	Key         string `json:"key" yaml:"key"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Lead is the username of the project lead, LeadAccountID its account ID on Jira Cloud
+	Lead          string `json:"lead,omitempty" yaml:"lead,omitempty"`
+	LeadAccountID string `json:"leadAccountId,omitempty" yaml:"leadAccountId,omitempty"`
+	// ProjectTypeKey and Template are only used to create the project, e.g. "software" and
+	// "com.pyxis.greenhopper.jira:gh-simplified-agility-scrum"
+	ProjectTypeKey string `json:"projectTypeKey,omitempty" yaml:"projectTypeKey,omitempty"`
+	Template       string `json:"template,omitempty" yaml:"template,omitempty"`
+
+	Components []ComponentSpec `json:"components,omitempty" yaml:"components,omitempty"`
+	Versions   []VersionSpec   `json:"versions,omitempty" yaml:"versions,omitempty"`
+	// Prune deletes the components and versions of the project that are not in the spec
+	Prune bool `json:"prune,omitempty" yaml:"prune,omitempty"`
+	// Absent deletes the project together with all its issues
+	Absent bool `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// ComponentSpec describes a component of a project, identified by its name
+type ComponentSpec struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Lead is the username of the component lead
+	Lead   string `json:"lead,omitempty" yaml:"lead,omitempty"`
+	Absent bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// VersionSpec describes a version of a project, identified by its name.
+// Dates are in the format 2006-01-02. Versions are released and archived,
+// but never unreleased or unarchived.
+type VersionSpec struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	StartDate   string `json:"startDate,omitempty" yaml:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty" yaml:"releaseDate,omitempty"`
+	Released    bool   `json:"released,omitempty" yaml:"released,omitempty"`
+	Archived    bool   `json:"archived,omitempty" yaml:"archived,omitempty"`
+	Absent      bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// FilterSpec describes a filter owned by the user of the client, identified by its name
+type FilterSpec struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	JQL         string `json:"jql" yaml:"jql"`
+	Absent      bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// BoardSpec describes a board, identified by its name. Boards cannot be
+// updated through the API, so a board whose type or filter differs from its
+// spec is an error rather than a change.
+type BoardSpec struct {
+	Name string `json:"name" yaml:"name"`
+	// Type is "scrum" or "kanban"
+	Type string `json:"type" yaml:"type"`
+	// Filter is the name of the filter of the board, a filter of the user of the client
+	Filter string `json:"filter" yaml:"filter"`
+	// Project is the key of the project the board is located in, if any
+	Project string `json:"project,omitempty" yaml:"project,omitempty"`
+	Absent  bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// IssueLinkTypeSpec describes an issue link type, identified by its name
+type IssueLinkTypeSpec struct {
+	Name    string `json:"name" yaml:"name"`
+	Inward  string `json:"inward" yaml:"inward"`
+	Outward string `json:"outward" yaml:"outward"`
+	Absent  bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// GroupSpec describes the members of an existing group. Groups are not created or deleted.
+type GroupSpec struct {
+	Name string `json:"name" yaml:"name"`
+	// Members are usernames, as used by Jira Server
+	Members []string `json:"members,omitempty" yaml:"members,omitempty"`
+	// AccountIDs are the account IDs of members, as used by Jira Cloud
+	AccountIDs []string `json:"accountIds,omitempty" yaml:"accountIds,omitempty"`
+	// Prune removes the members of the group that are not in the spec
+	Prune bool `json:"prune,omitempty" yaml:"prune,omitempty"`
+}
+
+// Load decodes a YAML or JSON spec. Unknown fields are an error, to catch typos.
+// JSON, though valid YAML, is decoded as JSON for clearer errors.
+func Load(r io.Reader) (*Spec, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("jiraconfig: %w", err)
+	}
+	spec := new(Spec)
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(spec); err != nil {
+			return nil, fmt.Errorf("jiraconfig: %w", err)
+		}
+	} else if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("jiraconfig: %w", err)
+	}
+	return spec, spec.Validate()
+}
+
+// Validate checks that all entries of the spec are named, that names are
+// unique and that boards do not use absent filters
+func (s *Spec) Validate() error {
+	seen := make(map[string]bool)
+	unique := func(kind, name string) error {
+		if name == "" {
+			return fmt.Errorf("jiraconfig: %s without a name", kind)
+		}
+		if seen[kind+"\x00"+name] {
+			return fmt.Errorf("jiraconfig: %s %q is specified twice", kind, name)
+		}
+		seen[kind+"\x00"+name] = true
+		return nil
+	}
+
+	for _, p := range s.Projects {
+		if err := unique("project", p.Key); err != nil {
+			return err
+		}
+		if p.Name == "" && !p.Absent {
+			return fmt.Errorf("jiraconfig: project %q without a name", p.Key)
+		}
+		for _, c := range p.Components {
+			if err := unique("component", p.Key+"/"+c.Name); err != nil {
+				return err
+			}
+		}
+		for _, v := range p.Versions {
+			if err := unique("version", p.Key+"/"+v.Name); err != nil {
+				return err
+			}
+		}
+	}
+	filters := make(map[string]bool)
+	for _, f := range s.Filters {
+		if err := unique("filter", f.Name); err != nil {
+			return err
+		}
+		if f.JQL == "" && !f.Absent {
+			return fmt.Errorf("jiraconfig: filter %q without JQL", f.Name)
+		}
+		filters[f.Name] = !f.Absent
+	}
+	for _, b := range s.Boards {
+		if err := unique("board", b.Name); err != nil {
+			return err
+		}
+		if b.Absent {
+			continue
+		}
+		if b.Type != "scrum" && b.Type != "kanban" {
+			return fmt.Errorf("jiraconfig: board %q has the type %q, want scrum or kanban", b.Name, b.Type)
+		}
+		if b.Filter == "" {
+			return fmt.Errorf("jiraconfig: board %q without a filter", b.Name)
+		}
+		if present, ok := filters[b.Filter]; ok && !present {
+			return fmt.Errorf("jiraconfig: board %q uses the absent filter %q", b.Name, b.Filter)
+		}
+	}
+	for _, l := range s.IssueLinkTypes {
+		if err := unique("issue link type", l.Name); err != nil {
+			return err
+		}
+	}
+	for _, g := range s.Groups {
+		if err := unique("group", g.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/go-querystring/query"
@@ -69,6 +70,8 @@ type ProjectComponent struct {
 	IsAssigneeTypeValid bool   `json:"isAssigneeTypeValid" structs:"isAssigneeTypeValid,omitempty"`
 	Project             string `json:"project" structs:"project,omitempty"`
 	ProjectID           int    `json:"projectId" structs:"projectId,omitempty"`
+	// IssueCount is only set in the paginated component list of a project
+	IssueCount int `json:"issueCount,omitempty" structs:"issueCount,omitempty"`
 }
 
 // PermissionScheme represents the permission scheme for the project
@@ -81,6 +84,43 @@ type PermissionScheme struct {
 	Permissions []Permission `json:"permissions" structs:"permissions,omitempty"`
 }
 
+// CreateProjectOptions are passed to the ProjectService.Create function to create a new Jira project
+type CreateProjectOptions struct {
+	Key                string `json:"key" structs:"key"`
+	Name               string `json:"name" structs:"name"`
+	ProjectTypeKey     string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty" structs:"projectTemplateKey,omitempty"`
+	Description        string `json:"description,omitempty" structs:"description,omitempty"`
+	// Lead is the username of the project lead, LeadAccountID its account ID on Jira Cloud
+	Lead                string `json:"lead,omitempty" structs:"lead,omitempty"`
+	LeadAccountID       string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	URL                 string `json:"url,omitempty" structs:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	AvatarID            int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme int    `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int    `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int    `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID          int    `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+}
+
+// UpdateProjectOptions are passed to the ProjectService.Update function.
+// Only the fields that are set are changed.
+type UpdateProjectOptions struct {
+	Key                 string `json:"key,omitempty" structs:"key,omitempty"`
+	Name                string `json:"name,omitempty" structs:"name,omitempty"`
+	ProjectTypeKey      string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+	Description         string `json:"description,omitempty" structs:"description,omitempty"`
+	Lead                string `json:"lead,omitempty" structs:"lead,omitempty"`
+	LeadAccountID       string `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	URL                 string `json:"url,omitempty" structs:"url,omitempty"`
+	AssigneeType        string `json:"assigneeType,omitempty" structs:"assigneeType,omitempty"`
+	AvatarID            int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	IssueSecurityScheme int    `json:"issueSecurityScheme,omitempty" structs:"issueSecurityScheme,omitempty"`
+	PermissionScheme    int    `json:"permissionScheme,omitempty" structs:"permissionScheme,omitempty"`
+	NotificationScheme  int    `json:"notificationScheme,omitempty" structs:"notificationScheme,omitempty"`
+	CategoryID          int    `json:"categoryId,omitempty" structs:"categoryId,omitempty"`
+}
+
 // GetListWithContext gets all projects form Jira
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/project-getAllProjects
@@ -180,3 +220,197 @@ func (s *ProjectService) GetPermissionSchemeWithContext(ctx context.Context, pro
 func (s *ProjectService) GetPermissionScheme(projectID string) (*PermissionScheme, *Response, error) {
 	return s.GetPermissionSchemeWithContext(context.Background(), projectID)
 }
+
+// CreateWithContext creates a new Jira project based on the given options.
+// Jira only replies with the ID and key of the new project, the other fields
+// of the returned Project are empty; use Get for the full representation.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-createProject
+func (s *ProjectService) CreateWithContext(ctx context.Context, options *CreateProjectOptions) (*Project, *Response, error) {
+	apiEndpoint := "rest/api/2/project"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The reply holds the id as a number, unlike any other project representation
+	reply := new(struct {
+		Self string      `json:"self"`
+		ID   json.Number `json:"id"`
+		Key  string      `json:"key"`
+	})
+	resp, err := s.client.Do(req, reply)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return &Project{Self: reply.Self, ID: reply.ID.String(), Key: reply.Key}, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *ProjectService) Create(options *CreateProjectOptions) (*Project, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// UpdateWithContext updates the project identified by projectID (id or key)
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-updateProject
+func (s *ProjectService) UpdateWithContext(ctx context.Context, projectID string, options *UpdateProjectOptions) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return project, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *ProjectService) Update(projectID string, options *UpdateProjectOptions) (*Project, *Response, error) {
+	return s.UpdateWithContext(context.Background(), projectID, options)
+}
+
+// DeleteWithContext deletes the project identified by projectID (id or key)
+// together with all its issues. This cannot be undone, see Archive for a
+// reversible alternative.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project-deleteProject
+func (s *ProjectService) DeleteWithContext(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *ProjectService) Delete(projectID string) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), projectID)
+}
+
+// ArchiveWithContext archives the project identified by projectID (id or key).
+// Archived projects are read-only and hidden, Restore brings them back.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-archive-post
+func (s *ProjectService) ArchiveWithContext(ctx context.Context, projectID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/archive", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Archive wraps ArchiveWithContext using the background context.
+func (s *ProjectService) Archive(projectID string) (*Response, error) {
+	return s.ArchiveWithContext(context.Background(), projectID)
+}
+
+// RestoreWithContext restores an archived or deleted project
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-projectidorkey-restore-post
+func (s *ProjectService) RestoreWithContext(ctx context.Context, projectID string) (*Project, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/restore", projectID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(Project)
+	resp, err := s.client.Do(req, project)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return project, resp, nil
+}
+
+// Restore wraps RestoreWithContext using the background context.
+func (s *ProjectService) Restore(projectID string) (*Project, *Response, error) {
+	return s.RestoreWithContext(context.Background(), projectID)
+}
+
+// AssignPermissionSchemeWithContext assigns the permission scheme schemeID to a project
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/project/{projectKeyOrId}/permissionscheme-assignPermissionScheme
+func (s *ProjectService) AssignPermissionSchemeWithContext(ctx context.Context, projectID string, schemeID int) (*PermissionScheme, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/permissionscheme", projectID)
+	body := struct {
+		ID int `json:"id"`
+	}{schemeID}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := new(PermissionScheme)
+	resp, err := s.client.Do(req, ps)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return ps, resp, nil
+}
+
+// AssignPermissionScheme wraps AssignPermissionSchemeWithContext using the background context.
+func (s *ProjectService) AssignPermissionScheme(projectID string, schemeID int) (*PermissionScheme, *Response, error) {
+	return s.AssignPermissionSchemeWithContext(context.Background(), projectID, schemeID)
+}
+
+// AssignNotificationSchemeWithContext assigns the notification scheme schemeID to a project.
+// Jira has no dedicated resource for this, the project is updated instead.
+func (s *ProjectService) AssignNotificationSchemeWithContext(ctx context.Context, projectID string, schemeID int) (*Project, *Response, error) {
+	return s.UpdateWithContext(ctx, projectID, &UpdateProjectOptions{NotificationScheme: schemeID})
+}
+
+// AssignNotificationScheme wraps AssignNotificationSchemeWithContext using the background context.
+func (s *ProjectService) AssignNotificationScheme(projectID string, schemeID int) (*Project, *Response, error) {
+	return s.AssignNotificationSchemeWithContext(context.Background(), projectID, schemeID)
+}
+
+// AssignIssueTypeSchemeWithContext assigns the issue type scheme schemeID to a project.
+// Both must be given by their numeric IDs.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-type-schemes/#api-rest-api-2-issuetypescheme-project-put
+func (s *ProjectService) AssignIssueTypeSchemeWithContext(ctx context.Context, projectID, schemeID string) (*Response, error) {
+	apiEndpoint := "rest/api/2/issuetypescheme/project"
+	body := struct {
+		IssueTypeSchemeID string `json:"issueTypeSchemeId"`
+		ProjectID         string `json:"projectId"`
+	}{schemeID, projectID}
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// AssignIssueTypeScheme wraps AssignIssueTypeSchemeWithContext using the background context.
+func (s *ProjectService) AssignIssueTypeScheme(projectID, schemeID string) (*Response, error) {
+	return s.AssignIssueTypeSchemeWithContext(context.Background(), projectID, schemeID)
+}
@@ -1,6 +1,7 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -160,3 +161,182 @@ func TestProjectService_GetPermissionScheme_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["key"] != "PAY" || body["projectTypeKey"] != "software" || body["lead"] != "fred" || body["permissionScheme"] != float64(10011) {
+			t.Errorf("Unexpected body %v", body)
+		}
+		if _, ok := body["notificationScheme"]; ok {
+			t.Error("Expected unset notificationScheme to be left out")
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/project/10042", "id": 10042, "key": "PAY"}`)
+	})
+
+	project, _, err := testClient.Project.Create(&CreateProjectOptions{
+		Key:              "PAY",
+		Name:             "Payments",
+		ProjectTypeKey:   "software",
+		Lead:             "fred",
+		PermissionScheme: 10011,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil {
+		t.Fatal("Expected project. Project is nil")
+	}
+	if project.Key != "PAY" {
+		t.Errorf("Expected key PAY but got %s", project.Key)
+	}
+}
+
+func TestProjectService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/12310505"
+
+	raw, err := ioutil.ReadFile("./mocks/project.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["notificationScheme"] != float64(10020) {
+			t.Errorf("Expected only the notification scheme to be sent, got %v", body)
+		}
+		fmt.Fprint(w, string(raw))
+	})
+
+	project, _, err := testClient.Project.AssignNotificationScheme("12310505", 10020)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil {
+		t.Error("Expected project. Project is nil")
+	}
+}
+
+func TestProjectService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/PAY"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestURL(t, r, testAPIEdpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.Delete("PAY"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Archive_Failure(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/PAY/archive"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages": ["Archiving projects requires Jira Premium."], "errors": {}}`)
+	})
+
+	if _, err := testClient.Project.Archive("PAY"); err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestProjectService_Restore(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/12310505/restore"
+
+	raw, err := ioutil.ReadFile("./mocks/project.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testRequestURL(t, r, testAPIEdpoint)
+		fmt.Fprint(w, string(raw))
+	})
+
+	project, _, err := testClient.Project.Restore("12310505")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if project == nil || project.ID != "12310505" {
+		t.Errorf("Expected project 12310505, got %+v", project)
+	}
+}
+
+func TestProjectService_AssignPermissionScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/project/99999999/permissionscheme"
+
+	raw, err := ioutil.ReadFile("./mocks/permissionscheme.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["id"] != float64(10100) {
+			t.Errorf("Expected scheme 10100, got %v", body)
+		}
+		fmt.Fprint(w, string(raw))
+	})
+
+	ps, _, err := testClient.Project.AssignPermissionScheme("99999999", 10100)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if ps == nil {
+		t.Error("Expected permission scheme. Permission scheme is nil")
+	}
+}
+
+func TestProjectService_AssignIssueTypeScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEdpoint := "/rest/api/2/issuetypescheme/project"
+
+	testMux.HandleFunc(testAPIEdpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testRequestURL(t, r, testAPIEdpoint)
+
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["issueTypeSchemeId"] != "10050" || body["projectId"] != "10042" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Project.AssignIssueTypeScheme("10042", "10050"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,219 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+)
+
+// maxIssuesPerRank is the maximum number of issues Jira ranks in one operation
+const maxIssuesPerRank = 50
+
+// RankIssuesOptions are passed to the IssueService.Rank function.
+// Exactly one of RankBeforeIssue and RankAfterIssue must be set.
+type RankIssuesOptions struct {
+	// Issues are the ids or keys of the issues to rank, in the order they should end up in
+	Issues          []string `json:"issues" structs:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty" structs:"rankBeforeIssue,omitempty"`
+	RankAfterIssue  string   `json:"rankAfterIssue,omitempty" structs:"rankAfterIssue,omitempty"`
+	// RankCustomFieldID is the ID of the rank field to use, the default rank field if 0
+	RankCustomFieldID int `json:"rankCustomFieldId,omitempty" structs:"rankCustomFieldId,omitempty"`
+}
+
+// RankResult holds the outcome of ranking a batch of issues.
+// Jira only reports entries if ranking some of the issues failed.
+type RankResult struct {
+	Entries []RankEntry `json:"entries" structs:"entries"`
+}
+
+// RankEntry is the outcome of ranking a single issue
+type RankEntry struct {
+	IssueID  int      `json:"issueId" structs:"issueId"`
+	IssueKey string   `json:"issueKey" structs:"issueKey"`
+	Status   int      `json:"status" structs:"status"`
+	Errors   []string `json:"errors" structs:"errors"`
+}
+
+// Failed returns the entries of the issues that could not be ranked
+func (r *RankResult) Failed() []RankEntry {
+	var failed []RankEntry
+	for _, e := range r.Entries {
+		if e.Status < 200 || e.Status > 299 {
+			failed = append(failed, e)
+		}
+	}
+	return failed
+}
+
+// RankWithContext moves issues before or after another issue, keeping their order.
+// Issues are ranked 50 at a time, the limit of a single operation.
+// Issues that could not be ranked are listed in RankResult.Failed.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/issue-rankIssues
+func (s *IssueService) RankWithContext(ctx context.Context, options *RankIssuesOptions) (*RankResult, *Response, error) {
+	if options.RankBeforeIssue == "" && options.RankAfterIssue == "" {
+		return nil, nil, errors.New("jira: no issue to rank before or after")
+	}
+	if options.RankBeforeIssue != "" && options.RankAfterIssue != "" {
+		return nil, nil, errors.New("jira: both an issue to rank before and after given")
+	}
+
+	result := new(RankResult)
+	var resp *Response
+	after := options.RankAfterIssue
+	for start := 0; start < len(options.Issues); start += maxIssuesPerRank {
+		end := start + maxIssuesPerRank
+		if end > len(options.Issues) {
+			end = len(options.Issues)
+		}
+		chunk := &RankIssuesOptions{
+			Issues:            options.Issues[start:end],
+			RankBeforeIssue:   options.RankBeforeIssue,
+			RankAfterIssue:    after,
+			RankCustomFieldID: options.RankCustomFieldID,
+		}
+
+		var err error
+		resp, err = s.rankWithContext(ctx, chunk, result)
+		if err != nil {
+			return result, resp, err
+		}
+		// The next chunk goes right after this one
+		if after != "" {
+			after = options.Issues[end-1]
+		}
+	}
+
+	return result, resp, nil
+}
+
+// Rank wraps RankWithContext using the background context.
+func (s *IssueService) Rank(options *RankIssuesOptions) (*RankResult, *Response, error) {
+	return s.RankWithContext(context.Background(), options)
+}
+
+// rankWithContext ranks at most 50 issues and adds the reported entries to result
+func (s *IssueService) rankWithContext(ctx context.Context, options *RankIssuesOptions, result *RankResult) (*Response, error) {
+	apiEndpoint := "rest/agile/1.0/issue/rank"
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusMultiStatus {
+		partial := new(RankResult)
+		if err := json.NewDecoder(resp.Body).Decode(partial); err != nil {
+			return resp, err
+		}
+		result.Entries = append(result.Entries, partial.Entries...)
+	}
+
+	return resp, nil
+}
+
+// ReorderWithContext ranks the issues so that they end up in the order of desired.
+// current is the present order of the issues, e.g. the backlog of a board.
+// Only the issues that are out of order are moved, the longest run of issues
+// that already are in order stays where it is.
+// Issues of current that are not in desired keep their rank.
+func (s *IssueService) ReorderWithContext(ctx context.Context, current, desired []string) (*RankResult, *Response, error) {
+	keep := inOrder(current, desired)
+
+	result := new(RankResult)
+	var resp *Response
+	var run []string
+	for _, issue := range desired {
+		if !keep[issue] {
+			run = append(run, issue)
+			continue
+		}
+		if len(run) > 0 {
+			var err error
+			resp, err = s.rankRun(ctx, &RankIssuesOptions{Issues: run, RankBeforeIssue: issue}, result)
+			if err != nil {
+				return result, resp, err
+			}
+			run = nil
+		}
+	}
+	if len(run) > 0 {
+		// The trailing run goes after the last issue that stays in place
+		options := &RankIssuesOptions{Issues: run}
+		if n := len(desired) - len(run); n > 0 {
+			options.RankAfterIssue = desired[n-1]
+		} else if len(current) > 0 {
+			// Nothing is in order, the issues are all moved to the top
+			options.RankBeforeIssue = current[0]
+		} else {
+			return result, nil, nil
+		}
+		var err error
+		resp, err = s.rankRun(ctx, options, result)
+		if err != nil {
+			return result, resp, err
+		}
+	}
+
+	return result, resp, nil
+}
+
+// Reorder wraps ReorderWithContext using the background context.
+func (s *IssueService) Reorder(current, desired []string) (*RankResult, *Response, error) {
+	return s.ReorderWithContext(context.Background(), current, desired)
+}
+
+// rankRun ranks a run of issues and adds the reported entries to result
+func (s *IssueService) rankRun(ctx context.Context, options *RankIssuesOptions, result *RankResult) (*Response, error) {
+	r, resp, err := s.RankWithContext(ctx, options)
+	if r != nil {
+		result.Entries = append(result.Entries, r.Entries...)
+	}
+	return resp, err
+}
+
+// inOrder returns the longest subsequence of desired that already is in the
+// order of current. These issues do not need to be ranked.
+func inOrder(current, desired []string) map[string]bool {
+	position := make(map[string]int, len(current))
+	for i, issue := range current {
+		position[issue] = i
+	}
+
+	// Patience sorting over the current positions of the desired issues
+	var tails []int
+	prev := make([]int, len(desired))
+	for i, issue := range desired {
+		p, ok := position[issue]
+		if !ok {
+			continue
+		}
+		n := sort.Search(len(tails), func(j int) bool { return position[desired[tails[j]]] >= p })
+		if n > 0 {
+			prev[i] = tails[n-1]
+		} else {
+			prev[i] = -1
+		}
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+
+	keep := make(map[string]bool, len(tails))
+	if len(tails) == 0 {
+		return keep
+	}
+	for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+		keep[desired[i]] = true
+	}
+	return keep
+}
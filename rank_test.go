@@ -0,0 +1,128 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestIssueService_Rank_Chunks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []RankIssuesOptions
+	testMux.HandleFunc("/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var body RankIssuesOptions
+		json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	issues := make([]string, 120)
+	for i := range issues {
+		issues[i] = fmt.Sprintf("PAY-%d", i+1)
+	}
+	result, _, err := testClient.Issue.Rank(&RankIssuesOptions{Issues: issues, RankAfterIssue: "PAY-500"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(result.Failed()) != 0 {
+		t.Errorf("Expected no failures, got %+v", result.Failed())
+	}
+	if len(calls) != 3 {
+		t.Fatalf("Expected 3 rank calls, got %d", len(calls))
+	}
+	for i, want := range []string{"PAY-500", "PAY-50", "PAY-100"} {
+		if calls[i].RankAfterIssue != want {
+			t.Errorf("Expected chunk %d after %s, got %s", i, want, calls[i].RankAfterIssue)
+		}
+	}
+	if len(calls[0].Issues) != 50 || len(calls[2].Issues) != 20 || calls[2].Issues[0] != "PAY-101" {
+		t.Errorf("Unexpected chunks %+v", calls)
+	}
+}
+
+func TestIssueService_Rank_PartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `{"entries": [{"issueId": 10000, "issueKey": "PAY-1", "status": 200}, {"issueId": 10001, "issueKey": "PAY-2", "status": 403, "errors": ["You do not have permission to rank issues."]}]}`)
+	})
+
+	result, _, err := testClient.Issue.Rank(&RankIssuesOptions{Issues: []string{"PAY-1", "PAY-2"}, RankBeforeIssue: "PAY-3"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	failed := result.Failed()
+	if len(failed) != 1 || failed[0].IssueKey != "PAY-2" || len(failed[0].Errors) != 1 {
+		t.Errorf("Expected PAY-2 to fail, got %+v", failed)
+	}
+}
+
+func TestIssueService_Rank_NoAnchor(t *testing.T) {
+	setup()
+	defer teardown()
+	if _, _, err := testClient.Issue.Rank(&RankIssuesOptions{Issues: []string{"PAY-1"}}); err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestIssueService_Rank_BothAnchors(t *testing.T) {
+	setup()
+	defer teardown()
+	options := &RankIssuesOptions{Issues: []string{"PAY-1"}, RankBeforeIssue: "PAY-5", RankAfterIssue: "PAY-4"}
+	if _, _, err := testClient.Issue.Rank(options); err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestIssueService_Reorder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []RankIssuesOptions
+	testMux.HandleFunc("/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		var body RankIssuesOptions
+		json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	current := []string{"A", "B", "C", "D", "E", "F"}
+	desired := []string{"E", "A", "B", "C", "F", "D"}
+	if _, _, err := testClient.Issue.Reorder(current, desired); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	want := []RankIssuesOptions{
+		{Issues: []string{"E"}, RankBeforeIssue: "A"},
+		{Issues: []string{"F"}, RankBeforeIssue: "D"},
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Expected rank calls %+v, got %+v", want, calls)
+	}
+}
+
+func TestIssueService_Reorder_InOrder(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no rank calls")
+	})
+
+	if _, _, err := testClient.Issue.Reorder([]string{"A", "X", "B", "C"}, []string{"A", "B", "C"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestInOrder(t *testing.T) {
+	keep := inOrder([]string{"A", "B", "C", "D", "E"}, []string{"D", "A", "E", "B", "C"})
+	want := map[string]bool{"A": true, "B": true, "C": true}
+	if !reflect.DeepEqual(keep, want) {
+		t.Errorf("Expected %v, got %v", want, keep)
+	}
+}
@@ -0,0 +1,255 @@
+// Package releasenotes generates the release notes of a Jira version from the
+// issues that have it as fix version. The notes are rendered through a Go
+// text/template, built-in templates produce Markdown, HTML and Jira wiki markup.
+package releasenotes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	jira "github.com/tya/go-jira"
+)
+
+// GroupBy selects how the issues of the notes are grouped
+type GroupBy string
+
+// Groupings of the issues
+const (
+	ByType      GroupBy = "type"
+	ByComponent GroupBy = "component"
+	ByLabel     GroupBy = "label"
+)
+
+// NoGroup is the name of the group of issues without component or label
+const NoGroup = "Other"
+
+// Options configure the generation of release notes
+type Options struct {
+	// VersionID is the fix version the notes are generated for
+	VersionID int
+	// JQL further restricts the issues, e.g. `resolution = Fixed`
+	JQL string
+	// GroupBy defaults to ByType
+	GroupBy GroupBy
+	// EpicLinkField is the ID of the "Epic Link" custom field, e.g. "customfield_10008".
+	// Parents of the issues that are epics are included as well.
+	EpicLinkField string
+}
+
+// Notes are the release notes of a version
+type Notes struct {
+	Version jira.Version
+	Groups  []Group
+	// Epics are the epics the issues belong to, ordered by key
+	Epics []Epic
+	// Issues are all issues of the notes, ordered by key
+	Issues []*Note
+}
+
+// Group is a set of issues sharing a type, component or label
+type Group struct {
+	Name   string
+	Issues []*Note
+}
+
+// Epic is an epic that issues of the notes belong to
+type Epic struct {
+	Key     string
+	Summary string
+	Issues  []*Note
+}
+
+// Note is an issue in the release notes
+type Note struct {
+	Key        string
+	Summary    string
+	Type       string
+	Status     string
+	Resolution string
+	Components []string
+	Labels     []string
+	// Epic is the key of the epic of the issue, if any
+	Epic string
+	// URL is the link to the issue in the Jira web UI
+	URL string
+}
+
+// Generate wraps GenerateWithContext using the background context
+func Generate(client *jira.Client, opts *Options) (*Notes, error) {
+	return GenerateWithContext(context.Background(), client, opts)
+}
+
+// GenerateWithContext collects the issues with opts.VersionID as fix version and groups them
+func GenerateWithContext(ctx context.Context, client *jira.Client, opts *Options) (*Notes, error) {
+	if opts.VersionID == 0 {
+		return nil, errors.New("releasenotes: no version given")
+	}
+	version, _, err := client.Version.GetWithContext(ctx, opts.VersionID)
+	if err != nil {
+		return nil, err
+	}
+
+	jql := fmt.Sprintf("fixVersion = %d", opts.VersionID)
+	if opts.JQL != "" {
+		jql += fmt.Sprintf(" AND (%s)", opts.JQL)
+	}
+	jql += " ORDER BY key"
+
+	fields := []string{"summary", "issuetype", "status", "resolution", "components", "labels", "parent"}
+	if opts.EpicLinkField != "" {
+		fields = append(fields, opts.EpicLinkField)
+	}
+
+	base := client.GetBaseURL()
+	notes := &Notes{Version: *version}
+	candidates := make(map[string]bool)
+	search := &jira.SearchOptions{MaxResults: 100, Fields: fields}
+	err = client.Issue.SearchPagesWithContext(ctx, jql, search, func(issue jira.Issue) error {
+		n := note(&issue, opts.EpicLinkField)
+		u := base
+		u.Path += "browse/" + n.Key
+		n.URL = u.String()
+		if n.Epic != "" {
+			candidates[n.Epic] = true
+		}
+		notes.Issues = append(notes.Issues, n)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := notes.linkEpics(ctx, client, candidates); err != nil {
+		return nil, err
+	}
+	notes.group(opts.GroupBy)
+	return notes, nil
+}
+
+// note converts issue, the epic is taken from the epic link field or the parent
+func note(issue *jira.Issue, epicLinkField string) *Note {
+	n := &Note{Key: issue.Key}
+	f := issue.Fields
+	if f == nil {
+		return n
+	}
+	n.Summary = f.Summary
+	n.Type = f.Type.Name
+	n.Labels = f.Labels
+	if f.Status != nil {
+		n.Status = f.Status.Name
+	}
+	if f.Resolution != nil {
+		n.Resolution = f.Resolution.Name
+	}
+	for _, c := range f.Components {
+		n.Components = append(n.Components, c.Name)
+	}
+	if epicLinkField != "" {
+		if key, ok := f.Unknowns.Value(epicLinkField); ok {
+			n.Epic, _ = key.(string)
+		}
+	}
+	if n.Epic == "" && f.Parent != nil {
+		n.Epic = f.Parent.Key
+	}
+	return n
+}
+
+// epicChunkSize is the number of candidate epic keys looked up per query
+var epicChunkSize = 50
+
+// linkEpics looks up the candidate epic keys and keeps those that are epics
+func (n *Notes) linkEpics(ctx context.Context, client *jira.Client, candidates map[string]bool) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(candidates))
+	for k := range candidates {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// Keys are queried in chunks to stay within the limits of the URL and the JQL.
+	// Validation only warns, so a deleted or hidden key does not fail the query.
+	epics := make(map[string]*Epic)
+	search := &jira.SearchOptions{MaxResults: 100, Fields: []string{"summary"}, ValidateQuery: "warn"}
+	for start := 0; start < len(keys); start += epicChunkSize {
+		end := start + epicChunkSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		jql := fmt.Sprintf("key in (%s) AND issuetype = Epic", strings.Join(keys[start:end], ","))
+		err := client.Issue.SearchPagesWithContext(ctx, jql, search, func(issue jira.Issue) error {
+			e := &Epic{Key: issue.Key}
+			if issue.Fields != nil {
+				e.Summary = issue.Fields.Summary
+			}
+			epics[issue.Key] = e
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, issue := range n.Issues {
+		e, ok := epics[issue.Epic]
+		if !ok {
+			// a parent that is not an epic, e.g. the story of a sub-task
+			issue.Epic = ""
+			continue
+		}
+		e.Issues = append(e.Issues, issue)
+	}
+	for _, k := range keys {
+		if e, ok := epics[k]; ok {
+			n.Epics = append(n.Epics, *e)
+		}
+	}
+	return nil
+}
+
+// group fills n.Groups, groups are ordered by name with NoGroup last
+func (n *Notes) group(by GroupBy) {
+	groups := make(map[string]*Group)
+	add := func(name string, issue *Note) {
+		g, ok := groups[name]
+		if !ok {
+			g = &Group{Name: name}
+			groups[name] = g
+		}
+		g.Issues = append(g.Issues, issue)
+	}
+
+	for _, issue := range n.Issues {
+		var names []string
+		switch by {
+		case ByComponent:
+			names = issue.Components
+		case ByLabel:
+			names = issue.Labels
+		default:
+			names = []string{issue.Type}
+		}
+		if len(names) == 0 {
+			names = []string{NoGroup}
+		}
+		for _, name := range names {
+			add(name, issue)
+		}
+	}
+
+	for _, g := range groups {
+		n.Groups = append(n.Groups, *g)
+	}
+	sort.Slice(n.Groups, func(i, j int) bool {
+		if (n.Groups[i].Name == NoGroup) != (n.Groups[j].Name == NoGroup) {
+			return n.Groups[j].Name == NoGroup
+		}
+		return n.Groups[i].Name < n.Groups[j].Name
+	})
+}
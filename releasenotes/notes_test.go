@@ -0,0 +1,176 @@
+package releasenotes
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/tya/go-jira"
+)
+
+// newJiraServer fakes the version and search endpoints. The epic queries are
+// appended to epicQueries if it is not nil, and checked against the single
+// query of the test data otherwise.
+func newJiraServer(t *testing.T, epicQueries *[]string) (*jira.Client, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/rest/api/2/version/10010", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "10010", "name": "1.4", "description": "Refunds and invoices", "releaseDate": "2020-06-01", "released": true}`)
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		file := "testdata/issues.json"
+		if strings.HasPrefix(jql, "key in") {
+			if v := r.URL.Query().Get("validateQuery"); v != "warn" {
+				t.Errorf("Expected the epic query to only warn, got validateQuery %q", v)
+			}
+			if epicQueries != nil {
+				*epicQueries = append(*epicQueries, jql)
+			} else if jql != "key in (PAY-1,PAY-100,PAY-101) AND issuetype = Epic" {
+				t.Errorf("Unexpected epic query %q", jql)
+			}
+			file = "testdata/epics.json"
+		} else if jql != "fixVersion = 10010 AND (resolution = Fixed) ORDER BY key" {
+			t.Errorf("Unexpected issue query %q", jql)
+		}
+		raw, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Write(raw)
+	})
+
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.Close
+}
+
+func generate(t *testing.T, by GroupBy) *Notes {
+	client, teardown := newJiraServer(t, nil)
+	defer teardown()
+
+	notes, err := Generate(client, &Options{VersionID: 10010, JQL: "resolution = Fixed", GroupBy: by, EpicLinkField: "customfield_10008"})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	return notes
+}
+
+func TestGenerate_epicChunks(t *testing.T) {
+	defer func(size int) { epicChunkSize = size }(epicChunkSize)
+	epicChunkSize = 2
+
+	var queries []string
+	client, teardown := newJiraServer(t, &queries)
+	defer teardown()
+
+	notes, err := Generate(client, &Options{VersionID: 10010, JQL: "resolution = Fixed", EpicLinkField: "customfield_10008"})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	want := "key in (PAY-1,PAY-100) AND issuetype = Epic\nkey in (PAY-101) AND issuetype = Epic"
+	if got := strings.Join(queries, "\n"); got != want {
+		t.Errorf("Unexpected epic queries:\n%s\nwant:\n%s", got, want)
+	}
+	if len(notes.Epics) != 2 {
+		t.Errorf("Expected 2 epics, got %+v", notes.Epics)
+	}
+}
+
+func groupNames(notes *Notes) string {
+	var names []string
+	for _, g := range notes.Groups {
+		names = append(names, fmt.Sprintf("%s:%d", g.Name, len(g.Issues)))
+	}
+	return strings.Join(names, " ")
+}
+
+func TestGenerate(t *testing.T) {
+	notes := generate(t, "")
+
+	if notes.Version.Name != "1.4" {
+		t.Errorf("Expected version 1.4 but got %s", notes.Version.Name)
+	}
+	if got, want := groupNames(notes), "Bug:1 Story:2 Sub-task:1"; got != want {
+		t.Errorf("Expected groups %q but got %q", want, got)
+	}
+	if len(notes.Epics) != 2 || notes.Epics[0].Key != "PAY-100" || len(notes.Epics[0].Issues) != 1 {
+		t.Errorf("Unexpected epics %+v", notes.Epics)
+	}
+	if sub := notes.Issues[2]; sub.Epic != "" {
+		t.Errorf("Expected the story parent of %s not to be an epic, got %s", sub.Key, sub.Epic)
+	}
+	if notes.Issues[3].Epic != "PAY-101" {
+		t.Errorf("Expected PAY-4 in epic PAY-101 by parent, got %q", notes.Issues[3].Epic)
+	}
+	if !strings.HasSuffix(notes.Issues[0].URL, "/browse/PAY-1") {
+		t.Errorf("Unexpected URL %s", notes.Issues[0].URL)
+	}
+}
+
+func TestGenerate_GroupBy(t *testing.T) {
+	if got, want := groupNames(generate(t, ByComponent)), "billing:2 checkout:1 Other:2"; got != want {
+		t.Errorf("Expected groups %q but got %q", want, got)
+	}
+	if got, want := groupNames(generate(t, ByLabel)), "customer:1 Other:3"; got != want {
+		t.Errorf("Expected groups %q but got %q", want, got)
+	}
+}
+
+func TestGenerate_NoVersion(t *testing.T) {
+	if _, err := Generate(nil, &Options{}); err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestNotes_Render(t *testing.T) {
+	notes := generate(t, ByType)
+
+	tests := []struct {
+		format Format
+		want   []string
+	}{
+		{Markdown, []string{"# Release notes 1.4", "## Bug", `) Refunds for \*partial\* payments`, "- PAY-100 Refunds (1 issues)"}},
+		{HTML, []string{"<h2>Story</h2>", "Checkout crashes on &lt;empty&gt; cart", `<a href="http`}},
+		{Wiki, []string{"h1. Release notes 1.4", "* [PAY-2|http", `Refunds for \*partial\* payments`}},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := notes.Render(&out, tt.format); err != nil {
+			t.Fatalf("%s: error given: %v", tt.format, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("%s: expected output to contain %q, got\n%s", tt.format, want, out.String())
+			}
+		}
+	}
+
+	if err := notes.Render(&bytes.Buffer{}, "pdf"); err == nil {
+		t.Error("No error given for unknown format")
+	}
+}
+
+func TestNotes_RenderTemplate(t *testing.T) {
+	notes := generate(t, ByType)
+
+	var out bytes.Buffer
+	err := notes.RenderTemplate(&out, `{{range .Issues}}{{.Key}}:{{join .Components "+"}} {{end}}`)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if want := "PAY-1:billing PAY-2:checkout+billing PAY-3: PAY-4: "; out.String() != want {
+		t.Errorf("Expected %q but got %q", want, out.String())
+	}
+
+	if err := notes.RenderTemplate(&out, `{{.Nope`); err == nil {
+		t.Error("No error given for invalid template")
+	}
+}
@@ -0,0 +1,106 @@
+package releasenotes
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// Format is an output format of the built-in templates
+type Format string
+
+// Formats of the built-in templates
+const (
+	Markdown Format = "markdown"
+	HTML     Format = "html"
+	Wiki     Format = "wiki"
+)
+
+// Templates are the built-in templates. They can serve as a starting point for
+// templates passed to RenderTemplate.
+var Templates = map[Format]string{
+	Markdown: `# Release notes {{.Version.Name}}
+{{with .Version.ReleaseDate}}
+Released {{.}}
+{{end}}{{with .Version.Description}}
+{{markdown .}}
+{{end}}{{range .Groups}}
+## {{markdown .Name}}
+
+{{range .Issues}}- [{{.Key}}]({{.URL}}) {{markdown .Summary}}
+{{end}}{{end}}{{if .Epics}}
+## Epics
+
+{{range .Epics}}- {{.Key}} {{markdown .Summary}} ({{len .Issues}} issues)
+{{end}}{{end}}`,
+
+	HTML: `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Release notes {{html .Version.Name}}</title>
+</head>
+<body>
+<h1>Release notes {{html .Version.Name}}</h1>
+{{with .Version.ReleaseDate}}<p>Released {{html .}}</p>
+{{end}}{{with .Version.Description}}<p>{{html .}}</p>
+{{end}}{{range .Groups}}<h2>{{html .Name}}</h2>
+<ul>
+{{range .Issues}}<li><a href="{{html .URL}}">{{.Key}}</a> {{html .Summary}}</li>
+{{end}}</ul>
+{{end}}{{if .Epics}}<h2>Epics</h2>
+<ul>
+{{range .Epics}}<li>{{.Key}} {{html .Summary}} ({{len .Issues}} issues)</li>
+{{end}}</ul>
+{{end}}</body>
+</html>
+`,
+
+	Wiki: `h1. Release notes {{wiki .Version.Name}}
+{{with .Version.ReleaseDate}}
+Released {{.}}
+{{end}}{{with .Version.Description}}
+{{wiki .}}
+{{end}}{{range .Groups}}
+h2. {{wiki .Name}}
+
+{{range .Issues}}* [{{.Key}}|{{.URL}}] {{wiki .Summary}}
+{{end}}{{end}}{{if .Epics}}
+h2. Epics
+
+{{range .Epics}}* {{.Key}} {{wiki .Summary}} ({{len .Issues}} issues)
+{{end}}{{end}}`,
+}
+
+var (
+	markdownEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`, `#`, `\#`)
+	wikiEscaper     = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `{`, `\{`, `}`, `\}`, `|`, `\|`)
+)
+
+// Funcs are available in templates in addition to the text/template built-ins.
+// markdown and wiki escape text for the respective markup, html is built in.
+var Funcs = template.FuncMap{
+	"markdown": markdownEscaper.Replace,
+	"wiki":     wikiEscaper.Replace,
+	"join":     strings.Join,
+}
+
+// Render writes the notes using the built-in template of format
+func (n *Notes) Render(w io.Writer, format Format) error {
+	tmpl, ok := Templates[format]
+	if !ok {
+		return fmt.Errorf("releasenotes: unknown format %q", format)
+	}
+	return n.RenderTemplate(w, tmpl)
+}
+
+// RenderTemplate writes the notes using tmpl, a Go text/template that is
+// executed with the Notes as data and has Funcs available
+func (n *Notes) RenderTemplate(w io.Writer, tmpl string) error {
+	t, err := template.New("notes").Funcs(Funcs).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("releasenotes: %w", err)
+	}
+	return t.Execute(w, n)
+}
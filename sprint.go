@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -121,3 +122,258 @@ func (s *SprintService) GetIssueWithContext(ctx context.Context, issueID string,
 func (s *SprintService) GetIssue(issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
 	return s.GetIssueWithContext(context.Background(), issueID, options)
 }
+
+// Sprint states, as used by SprintService.Start and SprintService.Complete
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// maxIssuesPerMove is the maximum number of issues Jira moves in one operation
+const maxIssuesPerMove = 50
+
+// CreateSprintOptions are passed to the SprintService.Create function to create a new sprint
+type CreateSprintOptions struct {
+	Name          string     `json:"name" structs:"name"`
+	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
+	StartDate     *time.Time `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	EndDate       *time.Time `json:"endDate,omitempty" structs:"endDate,omitempty"`
+	Goal          string     `json:"goal,omitempty" structs:"goal,omitempty"`
+}
+
+// UpdateSprintOptions are passed to the SprintService.Update function.
+// Only the fields that are set are changed.
+type UpdateSprintOptions struct {
+	Name      string     `json:"name,omitempty" structs:"name,omitempty"`
+	StartDate *time.Time `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	EndDate   *time.Time `json:"endDate,omitempty" structs:"endDate,omitempty"`
+	Goal      string     `json:"goal,omitempty" structs:"goal,omitempty"`
+	State     string     `json:"state,omitempty" structs:"state,omitempty"`
+}
+
+// CompleteSprintOptions are passed to the SprintService.Complete function
+type CompleteSprintOptions struct {
+	// MoveOpenIssuesTo is the ID of the sprint the issues that are not done
+	// are moved to. Jira moves them to the backlog if it is 0.
+	MoveOpenIssuesTo int
+}
+
+// sprintIssueOptions selects the issues of a sprint, page by page
+type sprintIssueOptions struct {
+	JQL        string `url:"jql,omitempty"`
+	Fields     string `url:"fields,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// sprintIssuesPage is a page of the issues of a sprint
+type sprintIssuesPage struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	Issues     []Issue `json:"issues"`
+}
+
+// GetWithContext returns the sprint identified by sprintID
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint-getSprint
+func (s *SprintService) GetWithContext(ctx context.Context, sprintID int) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return sprint, resp, nil
+}
+
+// Get wraps GetWithContext using the background context.
+func (s *SprintService) Get(sprintID int) (*Sprint, *Response, error) {
+	return s.GetWithContext(context.Background(), sprintID)
+}
+
+// CreateWithContext creates a future sprint on the board given by options.OriginBoardID
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint-createSprint
+func (s *SprintService) CreateWithContext(ctx context.Context, options *CreateSprintOptions) (*Sprint, *Response, error) {
+	apiEndpoint := "rest/agile/1.0/sprint"
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return sprint, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context.
+func (s *SprintService) Create(options *CreateSprintOptions) (*Sprint, *Response, error) {
+	return s.CreateWithContext(context.Background(), options)
+}
+
+// UpdateWithContext partially updates the sprint identified by sprintID.
+// Fields that are not set in options keep their value.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) UpdateWithContext(ctx context.Context, sprintID int, options *UpdateSprintOptions) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return sprint, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *SprintService) Update(sprintID int, options *UpdateSprintOptions) (*Sprint, *Response, error) {
+	return s.UpdateWithContext(context.Background(), sprintID, options)
+}
+
+// StartWithContext starts the future sprint identified by sprintID.
+// Jira requires start and end dates; if the sprint has none yet, they must be given.
+func (s *SprintService) StartWithContext(ctx context.Context, sprintID int, startDate, endDate *time.Time) (*Sprint, *Response, error) {
+	return s.UpdateWithContext(ctx, sprintID, &UpdateSprintOptions{
+		State:     SprintStateActive,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+}
+
+// Start wraps StartWithContext using the background context.
+func (s *SprintService) Start(sprintID int, startDate, endDate *time.Time) (*Sprint, *Response, error) {
+	return s.StartWithContext(context.Background(), sprintID, startDate, endDate)
+}
+
+// CompleteWithContext closes the active sprint identified by sprintID.
+// The issues of the sprint that are not done are moved to the sprint given by
+// options.MoveOpenIssuesTo before the sprint is closed. Without it Jira moves
+// them to the backlog when the sprint is closed.
+// If moving the issues fails, the sprint is left open.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint-partiallyUpdateSprint
+func (s *SprintService) CompleteWithContext(ctx context.Context, sprintID int, options *CompleteSprintOptions) (*Sprint, *Response, error) {
+	if options != nil && options.MoveOpenIssuesTo != 0 {
+		open, resp, err := s.openIssues(ctx, sprintID)
+		if err != nil {
+			return nil, resp, err
+		}
+		for start := 0; start < len(open); start += maxIssuesPerMove {
+			end := start + maxIssuesPerMove
+			if end > len(open) {
+				end = len(open)
+			}
+			if resp, err := s.MoveIssuesToSprintWithContext(ctx, options.MoveOpenIssuesTo, open[start:end]); err != nil {
+				return nil, resp, err
+			}
+		}
+	}
+
+	return s.UpdateWithContext(ctx, sprintID, &UpdateSprintOptions{State: SprintStateClosed})
+}
+
+// Complete wraps CompleteWithContext using the background context.
+func (s *SprintService) Complete(sprintID int, options *CompleteSprintOptions) (*Sprint, *Response, error) {
+	return s.CompleteWithContext(context.Background(), sprintID, options)
+}
+
+// openIssues returns the keys of the issues of a sprint that are not done
+func (s *SprintService) openIssues(ctx context.Context, sprintID int) ([]string, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
+	options := &sprintIssueOptions{JQL: "statusCategory != Done", Fields: "status", MaxResults: maxIssuesPerMove}
+
+	var keys []string
+	for {
+		u, err := addOptions(apiEndpoint, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		page := new(sprintIssuesPage)
+		resp, err := s.client.Do(req, page)
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		for _, issue := range page.Issues {
+			keys = append(keys, issue.Key)
+		}
+
+		options.StartAt += len(page.Issues)
+		if len(page.Issues) == 0 || options.StartAt >= page.Total {
+			return keys, resp, nil
+		}
+	}
+}
+
+// DeleteWithContext deletes the sprint identified by sprintID.
+// Its issues are moved to the backlog. Closed sprints cannot be deleted.
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint-deleteSprint
+func (s *SprintService) DeleteWithContext(ctx context.Context, sprintID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *SprintService) Delete(sprintID int) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), sprintID)
+}
+
+// SwapWithContext swaps the position of the sprint identified by sprintID with
+// the sprint identified by withSprintID on the board
+//
+// Jira API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint-swapSprint
+func (s *SprintService) SwapWithContext(ctx context.Context, sprintID, withSprintID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/swap", sprintID)
+	payload := struct {
+		SprintToSwapWith int `json:"sprintToSwapWith"`
+	}{withSprintID}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Swap wraps SwapWithContext using the background context.
+func (s *SprintService) Swap(sprintID, withSprintID int) (*Response, error) {
+	return s.SwapWithContext(context.Background(), sprintID, withSprintID)
+}
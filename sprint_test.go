@@ -6,7 +6,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestSprintService_MoveIssuesToSprint(t *testing.T) {
@@ -114,3 +116,178 @@ func TestSprintService_GetIssue(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestSprintService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestURL(t, r, "/rest/agile/1.0/sprint/37")
+		fmt.Fprint(w, `{"id": 37, "self": "http://www.example.com/jira/rest/agile/1.0/sprint/37", "state": "future", "name": "sprint 1", "originBoardId": 5, "goal": "sprint 1 goal"}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Get(37)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.Goal != "sprint 1 goal" || sprint.OriginBoardID != 5 {
+		t.Errorf("Unexpected sprint %+v", sprint)
+	}
+}
+
+func TestSprintService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 3 || body["name"] != "sprint 1" || body["originBoardId"] != float64(5) || body["goal"] != "ship it" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 37, "state": "future", "name": "sprint 1", "originBoardId": 5, "goal": "ship it"}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Create(&CreateSprintOptions{Name: "sprint 1", OriginBoardID: 5, Goal: "ship it"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.ID != 37 || sprint.State != SprintStateFuture {
+		t.Errorf("Unexpected sprint %+v", sprint)
+	}
+}
+
+func TestSprintService_Start(t *testing.T) {
+	setup()
+	defer teardown()
+	start := time.Date(2020, 4, 6, 9, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body UpdateSprintOptions
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.State != SprintStateActive || body.Name != "" || body.StartDate == nil || !body.StartDate.Equal(start) || body.EndDate == nil || !body.EndDate.Equal(end) {
+			t.Errorf("Unexpected body %+v", body)
+		}
+		fmt.Fprint(w, `{"id": 37, "state": "active", "name": "sprint 1", "startDate": "2020-04-06T09:00:00.000Z", "endDate": "2020-04-20T09:00:00.000Z"}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Start(37, &start, &end)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.State != SprintStateActive {
+		t.Errorf("Unexpected sprint %+v", sprint)
+	}
+}
+
+func TestSprintService_Complete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("jql") != "statusCategory != Done" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		// 60 open issues, returned in pages of 50
+		startAt := 0
+		fmt.Sscan(r.URL.Query().Get("startAt"), &startAt)
+		issues := []Issue{}
+		for i := startAt; i < 60 && i < startAt+50; i++ {
+			issues = append(issues, Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+		}
+		requests = append(requests, fmt.Sprintf("list %d", startAt))
+		json.NewEncoder(w).Encode(map[string]interface{}{"startAt": startAt, "maxResults": 50, "total": 60, "issues": issues})
+	})
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body UpdateSprintOptions
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.State != SprintStateClosed {
+			t.Errorf("Expected state closed, got %+v", body)
+		}
+		requests = append(requests, "close")
+		fmt.Fprint(w, `{"id": 37, "state": "closed", "name": "sprint 1"}`)
+	})
+	testMux.HandleFunc("/rest/agile/1.0/sprint/38/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var payload IssuesWrapper
+		json.NewDecoder(r.Body).Decode(&payload)
+		requests = append(requests, fmt.Sprintf("move %s..%s", payload.Issues[0], payload.Issues[len(payload.Issues)-1]))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	sprint, _, err := testClient.Sprint.Complete(37, &CompleteSprintOptions{MoveOpenIssuesTo: 38})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.State != SprintStateClosed {
+		t.Errorf("Unexpected sprint %+v", sprint)
+	}
+	want := "list 0, list 50, move TEST-1..TEST-50, move TEST-51..TEST-60, close"
+	if got := strings.Join(requests, ", "); got != want {
+		t.Errorf("Unexpected requests %q, want %q", got, want)
+	}
+
+	// Without a target sprint Jira moves the open issues to the backlog itself
+	requests = nil
+	if _, _, err := testClient.Sprint.Complete(37, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if got := strings.Join(requests, ", "); got != "close" {
+		t.Errorf("Expected the sprint to be closed only, got %q", got)
+	}
+}
+
+func TestSprintService_Complete_MoveFails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 1, "issues": [{"key": "TEST-1"}]}`)
+	})
+	testMux.HandleFunc("/rest/agile/1.0/sprint/38/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the sprint to be left open")
+	})
+
+	if _, _, err := testClient.Sprint.Complete(37, &CompleteSprintOptions{MoveOpenIssuesTo: 38}); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestSprintService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Sprint.Delete(37); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestSprintService_Swap(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint/37/swap", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["sprintToSwapWith"] != float64(38) {
+			t.Errorf("Unexpected body %v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Sprint.Swap(37, 38); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
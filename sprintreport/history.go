@@ -0,0 +1,136 @@
+package sprintreport
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+// change is a single change of a value at a point in time
+type change struct {
+	at       time.Time
+	from, to string
+}
+
+// history replays the changes of a value, e.g. the status of an issue
+type history struct {
+	current string
+	changes []change
+}
+
+// at returns the value at time t
+func (h *history) at(t time.Time) string {
+	for _, c := range h.changes {
+		if c.at.After(t) {
+			return c.from
+		}
+	}
+	return h.current
+}
+
+func (h *history) add(at time.Time, from, to string) {
+	if from != to {
+		h.changes = append(h.changes, change{at: at, from: from, to: to})
+	}
+}
+
+func (h *history) sort() {
+	sort.SliceStable(h.changes, func(i, j int) bool { return h.changes[i].at.Before(h.changes[j].at) })
+}
+
+// issueHistory is the sprint membership, estimate and status of an issue over time
+type issueHistory struct {
+	key      string
+	summary  string
+	created  time.Time
+	inSprint history
+	estimate history
+	status   history
+}
+
+// inSprintAt reports whether the issue was in the sprint at time t
+func (h *issueHistory) inSprintAt(t time.Time) bool {
+	return !t.Before(h.created) && h.inSprint.at(t) == "true"
+}
+
+// estimateAt returns the estimate of the issue at time t, 0 if it was not estimated
+func (h *issueHistory) estimateAt(t time.Time) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(h.estimate.at(t)), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// newIssueHistory replays the changelog of issue, which is currently in the sprint identified by sprintID
+func (c *collector) newIssueHistory(issue *jira.Issue, sprintID int) *issueHistory {
+	h := &issueHistory{
+		key:      issue.Key,
+		summary:  issue.Fields.Summary,
+		created:  time.Time(issue.Fields.Created),
+		inSprint: history{current: "true"},
+	}
+	if issue.Fields.Status != nil {
+		h.status.current = issue.Fields.Status.ID
+	}
+	if c.field == "" {
+		h.estimate.current = "1"
+	} else if v, ok := issue.Fields.Unknowns[c.field]; ok && v != nil {
+		h.estimate.current = format(v)
+	}
+
+	sprint := strconv.Itoa(sprintID)
+	if issue.Changelog != nil {
+		for _, entry := range issue.Changelog.Histories {
+			at, err := entry.CreatedTime()
+			if err != nil {
+				continue
+			}
+			for _, item := range entry.Items {
+				switch {
+				case item.Field == "Sprint":
+					h.inSprint.add(at, contains(item.From, sprint), contains(item.To, sprint))
+				case item.Field == "status":
+					h.status.add(at, format(item.From), format(item.To))
+				case c.field != "" && (item.FieldID == c.field || (item.FieldID == "" && item.Field == c.fieldName)):
+					h.estimate.add(at, item.FromString, item.ToString)
+				}
+			}
+		}
+	}
+	h.inSprint.sort()
+	h.status.sort()
+	h.estimate.sort()
+	// Closed sprints stay on the issue, so it may be found although it was moved on
+	if n := len(h.inSprint.changes); n > 0 {
+		h.inSprint.current = h.inSprint.changes[n-1].to
+	}
+	return h
+}
+
+// contains returns "true" if the comma separated list of sprint IDs ids contains id
+func contains(ids interface{}, id string) string {
+	for _, s := range strings.Split(format(ids), ",") {
+		if strings.TrimSpace(s) == id {
+			return "true"
+		}
+	}
+	return "false"
+}
+
+// format returns a changelog or field value as string
+func format(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
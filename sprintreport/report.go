@@ -0,0 +1,234 @@
+// Package sprintreport computes the committed and completed estimates, the
+// scope change and the burndown of a sprint, and the velocity of a board.
+// It replays the changelogs of the sprint issues, so that reports can be
+// made outside of Jira.
+package sprintreport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+// Report summarises a sprint. Estimates are in the unit of the estimation
+// field of the board, e.g. story points, or count issues if the board has none.
+// Sub-tasks are not counted, as on the boards.
+type Report struct {
+	Sprint jira.Sprint
+	// EstimationField is the ID of the field the board estimates issues with, empty if issues are counted
+	EstimationField string
+	// Start is when the sprint started, End when it was completed or, if
+	// it is still active, the current time
+	Start time.Time
+	End   time.Time
+
+	// Committed is the estimate of the issues in the sprint when it started
+	Committed float64
+	// Completed is the estimate of the issues in the sprint that were done when it ended
+	Completed float64
+	// Added and Removed are the estimates of the issues added to and removed from the sprint after it started
+	Added   float64
+	Removed float64
+	// Changed is the sum of the estimate changes of the issues in the sprint after it started
+	Changed float64
+
+	Issues   []Issue
+	Burndown []Point
+}
+
+// Issue is an issue that was in the sprint
+type Issue struct {
+	Key     string
+	Summary string
+	// Committed reports whether the issue was in the sprint when it started
+	Committed bool
+	// Added reports whether the issue was added to the sprint after it started
+	Added bool
+	// Removed reports whether the issue was not in the sprint when it ended
+	Removed bool
+	// Completed reports whether the issue was done when the sprint ended
+	Completed bool
+	// Estimate is the estimate of the issue when the sprint ended
+	Estimate float64
+}
+
+// Point is the remaining estimate of a sprint at a point in time
+type Point struct {
+	Time      time.Time
+	Remaining float64
+	// Ideal is the remaining estimate if the committed estimate was burnt down evenly
+	Ideal float64
+}
+
+// Collect wraps CollectWithContext using the background context
+func Collect(client *jira.Client, boardID, sprintID int) (*Report, error) {
+	return CollectWithContext(context.Background(), client, boardID, sprintID)
+}
+
+// CollectWithContext computes the report of a started sprint of a board.
+// The estimation field and the done statuses, those of the right-most column,
+// are taken from the board configuration.
+//
+// The search for the sprint issues does not find most issues that were removed
+// from the sprint while it was active, so Removed can be lower than on the
+// sprint report of Jira.
+func CollectWithContext(ctx context.Context, client *jira.Client, boardID, sprintID int) (*Report, error) {
+	c, err := newCollector(ctx, client, boardID)
+	if err != nil {
+		return nil, err
+	}
+	sprint, _, err := client.Sprint.GetWithContext(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	return c.report(ctx, sprint)
+}
+
+// collector computes the reports of the sprints of a board
+type collector struct {
+	client *jira.Client
+	// field is the ID of the estimation field, fieldName its name in changelogs
+	field     string
+	fieldName string
+	done      map[string]bool
+	now       func() time.Time
+}
+
+func newCollector(ctx context.Context, client *jira.Client, boardID int) (*collector, error) {
+	config, _, err := client.Board.GetBoardConfigurationWithContext(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &collector{client: client, done: make(map[string]bool), now: time.Now}
+	if config.Estimation.Type == "field" {
+		c.field = config.Estimation.Field.FieldID
+		c.fieldName = config.Estimation.Field.DisplayName
+	}
+	if columns := config.ColumnConfig.Columns; len(columns) > 0 {
+		for _, s := range columns[len(columns)-1].Status {
+			c.done[s.ID] = true
+		}
+	}
+	return c, nil
+}
+
+func (c *collector) report(ctx context.Context, sprint *jira.Sprint) (*Report, error) {
+	if sprint.StartDate == nil {
+		return nil, fmt.Errorf("sprintreport: sprint %d has not started", sprint.ID)
+	}
+	r := &Report{Sprint: *sprint, EstimationField: c.field, Start: *sprint.StartDate}
+	planned := r.Start
+	if sprint.EndDate != nil {
+		planned = *sprint.EndDate
+	}
+	switch {
+	case sprint.CompleteDate != nil:
+		r.End = *sprint.CompleteDate
+	case c.now().Before(planned):
+		r.End = c.now()
+	default:
+		r.End = planned
+	}
+	if r.End.Before(r.Start) {
+		return nil, errors.New("sprintreport: sprint ends before it starts")
+	}
+
+	fields := []string{"summary", "status", "issuetype", "created"}
+	if c.field != "" {
+		fields = append(fields, c.field)
+	}
+	var issues []*issueHistory
+	search := &jira.SearchOptions{MaxResults: 50, Expand: "changelog", Fields: fields}
+	err := c.client.Issue.SearchPagesWithContext(ctx, fmt.Sprintf("sprint = %d ORDER BY key", sprint.ID), search, func(issue jira.Issue) error {
+		if issue.Fields == nil || issue.Fields.Type.Subtask {
+			return nil
+		}
+		issues = append(issues, c.newIssueHistory(&issue, sprint.ID))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range issues {
+		r.Issues = append(r.Issues, c.summarise(r, h))
+	}
+	r.Burndown = c.burndown(issues, r.Start, r.End, planned, r.Committed)
+	return r, nil
+}
+
+// summarise adds the estimates of the issue to the totals of r
+func (c *collector) summarise(r *Report, h *issueHistory) Issue {
+	issue := Issue{
+		Key:       h.key,
+		Summary:   h.summary,
+		Committed: h.inSprintAt(r.Start),
+		Removed:   !h.inSprintAt(r.End),
+		Estimate:  h.estimateAt(r.End),
+	}
+	issue.Completed = !issue.Removed && c.done[h.status.at(r.End)]
+	if issue.Committed {
+		r.Committed += h.estimateAt(r.Start)
+	}
+	if issue.Completed {
+		r.Completed += issue.Estimate
+	}
+
+	during := func(t time.Time) bool { return t.After(r.Start) && !t.After(r.End) }
+	if during(h.created) && h.inSprintAt(h.created) {
+		issue.Added = true
+		r.Added += h.estimateAt(h.created)
+	}
+	for _, ch := range h.inSprint.changes {
+		if !during(ch.at) || ch.at.Before(h.created) {
+			continue
+		}
+		if ch.to == "true" {
+			issue.Added = true
+			r.Added += h.estimateAt(ch.at)
+		} else {
+			r.Removed += h.estimateAt(ch.at.Add(-time.Nanosecond))
+		}
+	}
+	for _, ch := range h.estimate.changes {
+		if during(ch.at) && h.inSprintAt(ch.at) {
+			r.Changed += h.estimateAt(ch.at) - h.estimateAt(ch.at.Add(-time.Nanosecond))
+		}
+	}
+	return issue
+}
+
+// burndown returns the remaining estimate when the sprint started, at the
+// end of every day and when it ended. The ideal line runs from committed at
+// start to zero at the planned end of the sprint.
+func (c *collector) burndown(issues []*issueHistory, start, end, planned time.Time, committed float64) []Point {
+	ideal := func(t time.Time) float64 {
+		if !planned.After(start) || !t.Before(planned) {
+			return 0
+		}
+		return committed * (1 - float64(t.Sub(start))/float64(planned.Sub(start)))
+	}
+	remaining := func(t time.Time) float64 {
+		var sum float64
+		for _, h := range issues {
+			if h.inSprintAt(t) && !c.done[h.status.at(t)] {
+				sum += h.estimateAt(t)
+			}
+		}
+		return sum
+	}
+
+	points := []Point{{Time: start, Remaining: remaining(start), Ideal: ideal(start)}}
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d+1, 0, 0, 0, 0, start.Location()); day.Before(end); day = day.AddDate(0, 0, 1) {
+		points = append(points, Point{Time: day, Remaining: remaining(day), Ideal: ideal(day)})
+	}
+	if end.After(start) {
+		points = append(points, Point{Time: end, Remaining: remaining(end), Ideal: ideal(end)})
+	}
+	return points
+}
@@ -0,0 +1,172 @@
+package sprintreport
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+// newJiraServer fakes the endpoints read by Collect. Board 5 estimates with
+// story points and has the closed sprints 35, 36 and 37. Only sprint 37 has
+// issues, see testdata/sprint_issues.json.
+func newJiraServer(t *testing.T) (*jira.Client, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/rest/agile/1.0/board/5/configuration", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 5, "name": "PAY board",
+			"columnConfig": {"columns": [
+				{"name": "To Do", "statuses": [{"id": "10000"}]},
+				{"name": "In Progress", "statuses": [{"id": "10001"}]},
+				{"name": "Done", "statuses": [{"id": "10002"}]}]},
+			"estimation": {"type": "field", "field": {"fieldId": "customfield_10002", "displayName": "Story Points"}}}`)
+	})
+	mux.HandleFunc("/rest/agile/1.0/board/5/sprint", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("state") != "closed" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"maxResults": 50, "startAt": 0, "isLast": true, "values": [
+			{"id": 37, "state": "closed", "name": "PAY Sprint 3", "startDate": "2020-04-06T09:00:00.000Z", "endDate": "2020-04-10T17:00:00.000Z", "completeDate": "2020-04-10T16:00:00.000Z"},
+			{"id": 35, "state": "closed", "name": "PAY Sprint 1", "startDate": "2020-03-09T09:00:00.000Z", "endDate": "2020-03-13T17:00:00.000Z", "completeDate": "2020-03-13T16:00:00.000Z"},
+			{"id": 36, "state": "closed", "name": "PAY Sprint 2", "startDate": "2020-03-23T09:00:00.000Z", "endDate": "2020-03-27T17:00:00.000Z", "completeDate": "2020-03-27T16:00:00.000Z"}]}`)
+	})
+	mux.HandleFunc("/rest/agile/1.0/sprint/37", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 37, "state": "closed", "name": "PAY Sprint 3", "originBoardId": 5, "startDate": "2020-04-06T09:00:00.000Z", "endDate": "2020-04-10T17:00:00.000Z", "completeDate": "2020-04-10T16:00:00.000Z"}`)
+	})
+	mux.HandleFunc("/rest/agile/1.0/sprint/39", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 39, "state": "future", "name": "PAY Sprint 5", "originBoardId": 5}`)
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("expand") != "changelog" {
+			t.Errorf("Expected changelogs to be expanded, got %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("jql") != "sprint = 37 ORDER BY key" {
+			fmt.Fprint(w, `{"startAt": 0, "maxResults": 50, "total": 0, "issues": []}`)
+			return
+		}
+		raw, err := ioutil.ReadFile("testdata/sprint_issues.json")
+		if err != nil {
+			t.Error(err)
+		}
+		w.Write(raw)
+	})
+
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.Close
+}
+
+func TestCollect(t *testing.T) {
+	client, teardown := newJiraServer(t)
+	defer teardown()
+
+	r, err := Collect(client, 5, 37)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if r.EstimationField != "customfield_10002" {
+		t.Errorf("Expected estimation field customfield_10002, got %q", r.EstimationField)
+	}
+	if r.Committed != 9 || r.Completed != 7 {
+		t.Errorf("Expected 9 committed and 7 completed, got %v and %v", r.Committed, r.Completed)
+	}
+	if r.Added != 2 || r.Removed != 1 || r.Changed != 5 {
+		t.Errorf("Expected 2 added, 1 removed and 5 changed, got %v, %v and %v", r.Added, r.Removed, r.Changed)
+	}
+
+	if len(r.Issues) != 4 {
+		t.Fatalf("Expected 4 issues without the sub-task, got %d", len(r.Issues))
+	}
+	want := []Issue{
+		{Key: "PAY-1", Summary: "Refund partially", Committed: true, Completed: true, Estimate: 5},
+		{Key: "PAY-2", Summary: "Store card fingerprints", Committed: true, Estimate: 8},
+		{Key: "PAY-3", Summary: "Retry declined cards", Added: true, Completed: true, Estimate: 2},
+		{Key: "PAY-5", Summary: "Update card icons", Committed: true, Removed: true, Estimate: 1},
+	}
+	for i, issue := range want {
+		if r.Issues[i] != issue {
+			t.Errorf("Expected %+v, got %+v", issue, r.Issues[i])
+		}
+	}
+}
+
+func TestCollect_Burndown(t *testing.T) {
+	client, teardown := newJiraServer(t)
+	defer teardown()
+
+	r, err := Collect(client, 5, 37)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+
+	day := func(d int) time.Time { return time.Date(2020, 4, d, 0, 0, 0, 0, time.UTC) }
+	want := []Point{
+		{Time: time.Date(2020, 4, 6, 9, 0, 0, 0, time.UTC), Remaining: 9, Ideal: 9},
+		{Time: day(7), Remaining: 9, Ideal: 9 * (1 - 15.0/104)},
+		{Time: day(8), Remaining: 13, Ideal: 9 * (1 - 39.0/104)},
+		{Time: day(9), Remaining: 10, Ideal: 9 * (1 - 63.0/104)},
+		{Time: day(10), Remaining: 8, Ideal: 9 * (1 - 87.0/104)},
+		{Time: time.Date(2020, 4, 10, 16, 0, 0, 0, time.UTC), Remaining: 8, Ideal: 9 * (1 - 103.0/104)},
+	}
+	if len(r.Burndown) != len(want) {
+		t.Fatalf("Expected %d points, got %+v", len(want), r.Burndown)
+	}
+	for i, p := range want {
+		got := r.Burndown[i]
+		if !got.Time.Equal(p.Time) || got.Remaining != p.Remaining || math.Abs(got.Ideal-p.Ideal) > 1e-9 {
+			t.Errorf("Expected point %+v, got %+v", p, got)
+		}
+	}
+}
+
+func TestCollect_NotStarted(t *testing.T) {
+	client, teardown := newJiraServer(t)
+	defer teardown()
+
+	if _, err := Collect(client, 5, 39); err == nil {
+		t.Error("Expected an error for a future sprint")
+	}
+}
+
+func TestCollectVelocity(t *testing.T) {
+	client, teardown := newJiraServer(t)
+	defer teardown()
+
+	v, err := CollectVelocity(client, 5, 2)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(v.Sprints) != 2 || v.Sprints[0].Sprint.ID != 36 || v.Sprints[1].Sprint.ID != 37 {
+		t.Fatalf("Expected sprints 36 and 37, got %+v", v.Sprints)
+	}
+	if v.AverageCommitted() != 4.5 || v.AverageCompleted() != 3.5 {
+		t.Errorf("Expected averages 4.5 and 3.5, got %v and %v", v.AverageCommitted(), v.AverageCompleted())
+	}
+}
+
+func TestCollectVelocity_All(t *testing.T) {
+	client, teardown := newJiraServer(t)
+	defer teardown()
+
+	v, err := CollectVelocity(client, 5, 0)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(v.Sprints) != 3 || v.Sprints[0].Sprint.ID != 35 || v.Sprints[2].Sprint.ID != 37 {
+		t.Errorf("Expected sprints 35, 36 and 37, got %+v", v.Sprints)
+	}
+}
+
+func TestCollectVelocity_NegativeCount(t *testing.T) {
+	if _, err := CollectVelocity(nil, 5, -1); err == nil {
+		t.Error("No error given")
+	}
+}
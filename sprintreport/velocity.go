@@ -0,0 +1,93 @@
+package sprintreport
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+// Velocity holds the reports of the last closed sprints of a board, oldest first
+type Velocity struct {
+	Sprints []Report
+}
+
+// AverageCommitted returns the average committed estimate of the sprints
+func (v *Velocity) AverageCommitted() float64 {
+	return v.average(func(r *Report) float64 { return r.Committed })
+}
+
+// AverageCompleted returns the average completed estimate of the sprints
+func (v *Velocity) AverageCompleted() float64 {
+	return v.average(func(r *Report) float64 { return r.Completed })
+}
+
+func (v *Velocity) average(value func(*Report) float64) float64 {
+	if len(v.Sprints) == 0 {
+		return 0
+	}
+	var sum float64
+	for i := range v.Sprints {
+		sum += value(&v.Sprints[i])
+	}
+	return sum / float64(len(v.Sprints))
+}
+
+// CollectVelocity wraps CollectVelocityWithContext using the background context
+func CollectVelocity(client *jira.Client, boardID, count int) (*Velocity, error) {
+	return CollectVelocityWithContext(context.Background(), client, boardID, count)
+}
+
+// CollectVelocityWithContext computes the reports of the last count closed
+// sprints of a board, ordered by the date they were completed. A count of 0
+// selects all closed sprints.
+func CollectVelocityWithContext(ctx context.Context, client *jira.Client, boardID, count int) (*Velocity, error) {
+	if count < 0 {
+		return nil, errors.New("sprintreport: negative sprint count")
+	}
+	c, err := newCollector(ctx, client, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sprints []jira.Sprint
+	opts := &jira.GetAllSprintsOptions{State: jira.SprintStateClosed}
+	for {
+		page, _, err := client.Board.GetAllSprintsWithOptionsWithContext(ctx, boardID, opts)
+		if err != nil {
+			return nil, err
+		}
+		sprints = append(sprints, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		opts.StartAt += len(page.Values)
+	}
+	sort.SliceStable(sprints, func(i, j int) bool { return completed(&sprints[i]).Before(completed(&sprints[j])) })
+	if count > 0 && len(sprints) > count {
+		sprints = sprints[len(sprints)-count:]
+	}
+
+	v := new(Velocity)
+	for i := range sprints {
+		r, err := c.report(ctx, &sprints[i])
+		if err != nil {
+			return nil, err
+		}
+		v.Sprints = append(v.Sprints, *r)
+	}
+	return v, nil
+}
+
+// completed returns when the sprint was completed, or when it was planned to end
+func completed(s *jira.Sprint) (t time.Time) {
+	switch {
+	case s.CompleteDate != nil:
+		return *s.CompleteDate
+	case s.EndDate != nil:
+		return *s.EndDate
+	}
+	return t
+}
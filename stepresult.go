@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	StepResultListError   = "Step Result List Error"
+	StepResultGetError    = "Step Result Get Error"
+	StepResultUpdateError = "Step Result Update Error"
+)
+
+var (
+	stepResultEndpoint       = "/rest/zapi/latest/stepResult"
+	stepResultEndpointFormat = "/rest/zapi/latest/stepResult/%d"
+)
+
+// StepResultService handles the step level results of a Zephyr execution.
+//
+// Zephyr API docs: https://getzephyr.docs.apiary.io/#reference/stepresultresource
+type StepResultService struct {
+	client *Client
+}
+
+// StepResult represents the result of a single test step within an execution
+type StepResult struct {
+	ID          int    `json:"id,omitempty"`
+	ExecutionID int    `json:"executionId,omitempty"`
+	IssueID     int    `json:"issueId,omitempty"`
+	StepID      int    `json:"stepId,omitempty"`
+	OrderID     int    `json:"orderId,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+	HTMLComment string `json:"htmlComment,omitempty"`
+	ExecutedOn  int64  `json:"executedOn,omitempty"`
+	ExecutedBy  string `json:"executedBy,omitempty"`
+	CreatedBy   string `json:"createdBy,omitempty"`
+	ModifiedBy  string `json:"modifiedBy,omitempty"`
+
+	Defects []Defect `json:"defects,omitempty"`
+}
+
+// StepResultListOptions parameters to the StepResultService.GetList
+type StepResultListOptions struct {
+	ExecutionID int  `url:"executionId"`
+	Expand      bool `url:"expand,omitempty"`
+}
+
+// StepResultUpdate holds the step level status and comment sent by StepResultService.Update
+type StepResultUpdate struct {
+	Status  StatusID `json:"status,string,omitempty"`
+	Comment string   `json:"comment,omitempty"`
+}
+
+// GetListWithContext gets the step results of an execution
+func (s *StepResultService) GetListWithContext(ctx context.Context, opts *StepResultListOptions) ([]StepResult, *Response, error) {
+	url, err := addOptions(stepResultEndpoint, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", StepResultListError, err)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", StepResultListError, err)
+	}
+
+	var results []StepResult
+	resp, err := s.client.Do(req, &results)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", StepResultListError, err)
+	}
+	return results, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context
+func (s *StepResultService) GetList(opts *StepResultListOptions) ([]StepResult, *Response, error) {
+	return s.GetListWithContext(context.Background(), opts)
+}
+
+// GetWithContext gets a single step result
+func (s *StepResultService) GetWithContext(ctx context.Context, stepResultID int) (*StepResult, *Response, error) {
+	endpoint := fmt.Sprintf(stepResultEndpointFormat, stepResultID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", StepResultGetError, err)
+	}
+
+	result := new(StepResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", StepResultGetError, err)
+	}
+	return result, resp, nil
+}
+
+// Get wraps GetWithContext using the background context
+func (s *StepResultService) Get(stepResultID int) (*StepResult, *Response, error) {
+	return s.GetWithContext(context.Background(), stepResultID)
+}
+
+// UpdateWithContext sets the status and comment of a step result
+func (s *StepResultService) UpdateWithContext(ctx context.Context, stepResultID int, update *StepResultUpdate) (*StepResult, *Response, error) {
+	endpoint := fmt.Sprintf(stepResultEndpointFormat, stepResultID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, endpoint, update)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", StepResultUpdateError, err)
+	}
+
+	result := new(StepResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", StepResultUpdateError, err)
+	}
+	return result, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context
+func (s *StepResultService) Update(stepResultID int, update *StepResultUpdate) (*StepResult, *Response, error) {
+	return s.UpdateWithContext(context.Background(), stepResultID, update)
+}
@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestStepResultService_GetList_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/step_results.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(stepResultEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, stepResultEndpoint)
+		testRequestParams(t, r, map[string]string{"executionId": "13377"})
+		fmt.Fprint(w, string(raw))
+	})
+
+	results, _, err := testClient.StepResult.GetList(&StepResultListOptions{ExecutionID: 13377})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("Expected %d step results but got %d", 2, len(results))
+		return
+	}
+	if results[1].Status != "2" {
+		t.Errorf("Expected status 2 but got %s", results[1].Status)
+	}
+}
+
+func TestStepResultService_GetList_RequestError(t *testing.T) {
+	setup()
+	backup := stepResultEndpoint
+	defer func() {
+		stepResultEndpoint = backup
+		teardown()
+	}()
+
+	// set an invalid endpoint to trigger a request error
+	stepResultEndpoint = "\r"
+
+	results, _, err := testClient.StepResult.GetList(nil)
+	if results != nil {
+		t.Errorf("Actual step result list has %d entries but expected nil", len(results))
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestStepResultService_GetList_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(stepResultEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	results, _, err := testClient.StepResult.GetList(&StepResultListOptions{ExecutionID: 1})
+	if results != nil {
+		t.Errorf("Actual step result list has %d entries but expected nil", len(results))
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestStepResultService_Update_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	id := 52
+	endpoint := fmt.Sprintf(stepResultEndpointFormat, id)
+
+	raw, err := ioutil.ReadFile("./mocks/step_result.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, endpoint)
+
+		update := new(StepResultUpdate)
+		if err := json.NewDecoder(r.Body).Decode(update); err != nil {
+			t.Error(err.Error())
+		}
+		if update.Status != StatusPass {
+			t.Errorf("Server expected status 1 but got %s", update.Status)
+		}
+		fmt.Fprint(w, string(raw))
+	})
+
+	result, _, err := testClient.StepResult.Update(id, &StepResultUpdate{Status: StatusPass, Comment: "passed on retry"})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if result == nil {
+		t.Error("Expected step result. Reply is nil")
+		return
+	}
+	if result.Comment != "passed on retry" {
+		t.Errorf("Expected comment %q but got %q", "passed on retry", result.Comment)
+	}
+}
+
+func TestStepResultService_Update_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	id := 52
+	endpoint := fmt.Sprintf(stepResultEndpointFormat, id)
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	result, _, err := testClient.StepResult.Update(id, &StepResultUpdate{Status: StatusPass})
+	if result != nil {
+		t.Errorf("Expected step result to be nil, %v", result)
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
@@ -0,0 +1,221 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+const (
+	TestAttachmentListError   = "Test Attachment List Error"
+	TestAttachmentPostError   = "Test Attachment Post Error"
+	TestAttachmentGetError    = "Test Attachment Get Error"
+	TestAttachmentDeleteError = "Test Attachment Delete Error"
+)
+
+var (
+	testAttachmentEndpoint           = "/rest/zapi/latest/attachment"
+	testAttachmentByEntityEndpoint   = "/rest/zapi/latest/attachment/attachmentsByEntity"
+	testAttachmentItemEndpointFormat = "/rest/zapi/latest/attachment/%s"
+	testAttachmentFileEndpointFormat = "/rest/zapi/latest/attachment/%s/file"
+)
+
+// entity types of the ZAPI attachment endpoints
+const (
+	testAttachmentExecutionEntityType  = "EXECUTION"
+	testAttachmentStepResultEntityType = "TESTSTEPRESULT"
+)
+
+// TestAttachment is a file attached to a Zephyr execution or step result
+type TestAttachment struct {
+	FileID      string `json:"fileId"`
+	FileName    string `json:"fileName,omitempty"`
+	FileSize    string `json:"fileSize,omitempty"`
+	MimeType    string `json:"mimetype,omitempty"`
+	Comment     string `json:"comment,omitempty"`
+	Author      string `json:"author,omitempty"`
+	DateCreated string `json:"dateCreated,omitempty"`
+}
+
+// testAttachmentOptions identify the execution or step result an attachment belongs to
+type testAttachmentOptions struct {
+	EntityID   int    `url:"entityId"`
+	EntityType string `url:"entityType"`
+}
+
+// testAttachmentList is the reply of the attachmentsByEntity endpoint
+type testAttachmentList struct {
+	Data []TestAttachment `json:"data"`
+}
+
+func (c *Client) getTestAttachments(ctx context.Context, opts *testAttachmentOptions) ([]TestAttachment, *Response, error) {
+	url, err := addOptions(testAttachmentByEntityEndpoint, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", TestAttachmentListError, err)
+	}
+	req, err := c.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", TestAttachmentListError, err)
+	}
+
+	list := new(testAttachmentList)
+	resp, err := c.Do(req, list)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", TestAttachmentListError, err)
+	}
+	return list.Data, resp, nil
+}
+
+// postTestAttachment streams r as a multipart upload, so that large logs and
+// screenshots are never held in memory as a whole
+func (c *Client) postTestAttachment(ctx context.Context, opts *testAttachmentOptions, r io.Reader, name string) (*Response, error) {
+	url, err := addOptions(testAttachmentEndpoint, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", TestAttachmentPostError, err)
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	req, err := c.newMultiPartRequestWithContext(ctx, http.MethodPost, url, pr)
+	if err != nil {
+		pr.Close()
+		return nil, fmt.Errorf("%s: %w", TestAttachmentPostError, err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	go func() {
+		fw, err := writer.CreateFormFile("file", name)
+		if err == nil && r != nil {
+			_, err = io.Copy(fw, r)
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	resp, err := c.Do(req, nil)
+	// Unblock the writer in case the request ended before the body was consumed
+	pr.Close()
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", TestAttachmentPostError, err)
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+func (c *Client) downloadTestAttachment(ctx context.Context, attachmentID string) (*Response, error) {
+	endpoint := fmt.Sprintf(testAttachmentFileEndpointFormat, attachmentID)
+	req, err := c.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", TestAttachmentGetError, err)
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", TestAttachmentGetError, err)
+	}
+	return resp, nil
+}
+
+func (c *Client) deleteTestAttachment(ctx context.Context, attachmentID string) (*Response, error) {
+	endpoint := fmt.Sprintf(testAttachmentItemEndpointFormat, attachmentID)
+	req, err := c.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", TestAttachmentDeleteError, err)
+	}
+
+	resp, err := c.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", TestAttachmentDeleteError, err)
+	}
+	return resp, nil
+}
+
+// GetAttachmentsWithContext lists the files attached to an execution
+func (s *ExecutionService) GetAttachmentsWithContext(ctx context.Context, exeID int) ([]TestAttachment, *Response, error) {
+	return s.client.getTestAttachments(ctx, &testAttachmentOptions{EntityID: exeID, EntityType: testAttachmentExecutionEntityType})
+}
+
+// GetAttachments wraps GetAttachmentsWithContext using the background context
+func (s *ExecutionService) GetAttachments(exeID int) ([]TestAttachment, *Response, error) {
+	return s.GetAttachmentsWithContext(context.Background(), exeID)
+}
+
+// PostAttachmentWithContext uploads r (io.Reader) as an attachment to an execution.
+// The content is streamed, it is read while the request is sent.
+func (s *ExecutionService) PostAttachmentWithContext(ctx context.Context, exeID int, r io.Reader, attachmentName string) (*Response, error) {
+	return s.client.postTestAttachment(ctx, &testAttachmentOptions{EntityID: exeID, EntityType: testAttachmentExecutionEntityType}, r, attachmentName)
+}
+
+// PostAttachment wraps PostAttachmentWithContext using the background context
+func (s *ExecutionService) PostAttachment(exeID int, r io.Reader, attachmentName string) (*Response, error) {
+	return s.PostAttachmentWithContext(context.Background(), exeID, r, attachmentName)
+}
+
+// DownloadAttachmentWithContext returns a Response of an execution attachment.
+// The attachment is in the Response.Body of the response.
+// The caller should close the resp.Body.
+func (s *ExecutionService) DownloadAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error) {
+	return s.client.downloadTestAttachment(ctx, attachmentID)
+}
+
+// DownloadAttachment wraps DownloadAttachmentWithContext using the background context
+func (s *ExecutionService) DownloadAttachment(attachmentID string) (*Response, error) {
+	return s.DownloadAttachmentWithContext(context.Background(), attachmentID)
+}
+
+// DeleteAttachmentWithContext deletes an execution attachment
+func (s *ExecutionService) DeleteAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error) {
+	return s.client.deleteTestAttachment(ctx, attachmentID)
+}
+
+// DeleteAttachment wraps DeleteAttachmentWithContext using the background context
+func (s *ExecutionService) DeleteAttachment(attachmentID string) (*Response, error) {
+	return s.DeleteAttachmentWithContext(context.Background(), attachmentID)
+}
+
+// GetAttachmentsWithContext lists the files attached to a step result
+func (s *StepResultService) GetAttachmentsWithContext(ctx context.Context, stepResultID int) ([]TestAttachment, *Response, error) {
+	return s.client.getTestAttachments(ctx, &testAttachmentOptions{EntityID: stepResultID, EntityType: testAttachmentStepResultEntityType})
+}
+
+// GetAttachments wraps GetAttachmentsWithContext using the background context
+func (s *StepResultService) GetAttachments(stepResultID int) ([]TestAttachment, *Response, error) {
+	return s.GetAttachmentsWithContext(context.Background(), stepResultID)
+}
+
+// PostAttachmentWithContext uploads r (io.Reader) as an attachment to a step result.
+// The content is streamed, it is read while the request is sent.
+func (s *StepResultService) PostAttachmentWithContext(ctx context.Context, stepResultID int, r io.Reader, attachmentName string) (*Response, error) {
+	return s.client.postTestAttachment(ctx, &testAttachmentOptions{EntityID: stepResultID, EntityType: testAttachmentStepResultEntityType}, r, attachmentName)
+}
+
+// PostAttachment wraps PostAttachmentWithContext using the background context
+func (s *StepResultService) PostAttachment(stepResultID int, r io.Reader, attachmentName string) (*Response, error) {
+	return s.PostAttachmentWithContext(context.Background(), stepResultID, r, attachmentName)
+}
+
+// DownloadAttachmentWithContext returns a Response of a step result attachment.
+// The attachment is in the Response.Body of the response.
+// The caller should close the resp.Body.
+func (s *StepResultService) DownloadAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error) {
+	return s.client.downloadTestAttachment(ctx, attachmentID)
+}
+
+// DownloadAttachment wraps DownloadAttachmentWithContext using the background context
+func (s *StepResultService) DownloadAttachment(attachmentID string) (*Response, error) {
+	return s.DownloadAttachmentWithContext(context.Background(), attachmentID)
+}
+
+// DeleteAttachmentWithContext deletes a step result attachment
+func (s *StepResultService) DeleteAttachmentWithContext(ctx context.Context, attachmentID string) (*Response, error) {
+	return s.client.deleteTestAttachment(ctx, attachmentID)
+}
+
+// DeleteAttachment wraps DeleteAttachmentWithContext using the background context
+func (s *StepResultService) DeleteAttachment(attachmentID string) (*Response, error) {
+	return s.DeleteAttachmentWithContext(context.Background(), attachmentID)
+}
@@ -0,0 +1,163 @@
+package jira
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExecutionService_GetAttachments_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/test_attachments.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(testAttachmentByEntityEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"entityId": "13377", "entityType": "EXECUTION"})
+		fmt.Fprint(w, string(raw))
+	})
+
+	attachments, _, err := testClient.Execution.GetAttachments(13377)
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if len(attachments) != 2 {
+		t.Fatalf("Expected 2 attachments but got %d", len(attachments))
+	}
+	if attachments[0].FileID != "25" || attachments[0].FileName != "login-failure.png" {
+		t.Errorf("Unexpected attachment %+v", attachments[0])
+	}
+}
+
+func TestExecutionService_GetAttachments_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(testAttachmentByEntityEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	attachments, _, err := testClient.Execution.GetAttachments(13377)
+	if attachments != nil {
+		t.Errorf("Expected attachments to be nil, %v", attachments)
+	}
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestStepResultService_PostAttachment_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(testAttachmentEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestParams(t, r, map[string]string{"entityId": "52", "entityType": "TESTSTEPRESULT"})
+		if r.Header.Get("X-Atlassian-Token") != "nocheck" {
+			t.Error("Expected X-Atlassian-Token nocheck header")
+		}
+		if r.ContentLength != -1 {
+			t.Errorf("Expected a streamed body of unknown length, got %d", r.ContentLength)
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		content, _ := ioutil.ReadAll(file)
+		if header.Filename != "server.log" || string(content) != "connection refused" {
+			t.Errorf("Unexpected upload %s: %q", header.Filename, content)
+		}
+		fmt.Fprint(w, `{"success": "Attachment uploaded"}`)
+	})
+
+	_, err := testClient.StepResult.PostAttachment(52, strings.NewReader("connection refused"), "server.log")
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+}
+
+func TestExecutionService_PostAttachment_RequestError(t *testing.T) {
+	setup()
+	backup := testAttachmentEndpoint
+	defer func() {
+		testAttachmentEndpoint = backup
+		teardown()
+	}()
+
+	// set an invalid testAttachmentEndpoint to trigger a request error
+	testAttachmentEndpoint = "\r"
+
+	_, err := testClient.Execution.PostAttachment(13377, strings.NewReader("x"), "x.txt")
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestExecutionService_PostAttachment_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(testAttachmentEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		// reply before the body has been read
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := testClient.Execution.PostAttachment(13377, strings.NewReader(strings.Repeat("x", 1<<20)), "x.txt")
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestExecutionService_DownloadAttachment_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(fmt.Sprintf(testAttachmentFileEndpointFormat, "26"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "connection refused")
+	})
+
+	resp, err := testClient.Execution.DownloadAttachment("26")
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	defer resp.Body.Close()
+	content, _ := ioutil.ReadAll(resp.Body)
+	if string(content) != "connection refused" {
+		t.Errorf("Unexpected content %q", content)
+	}
+}
+
+func TestStepResultService_DeleteAttachment_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(fmt.Sprintf(testAttachmentItemEndpointFormat, "26"), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"success": "Attachment 26 deleted"}`)
+	})
+
+	if _, err := testClient.StepResult.DeleteAttachment("26"); err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+}
+
+func TestStepResultService_DeleteAttachment_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc(fmt.Sprintf(testAttachmentItemEndpointFormat, "26"), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := testClient.StepResult.DeleteAttachment("26"); err == nil {
+		t.Error("No error given")
+	}
+}
@@ -0,0 +1,42 @@
+package testreport
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// csvHeader are the columns written by WriteCSV
+var csvHeader = []string{"scope", "cycle", "name", "total", "executed", "passed", "failed", "other", "pass_rate", "defects"}
+
+// WriteCSV writes one row per summary: the total, every cycle followed by its
+// folders, every component and every executor. The scope column tells them apart.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	row := func(scope, cycle string, s *Summary) {
+		cw.Write([]string{
+			scope, cycle, s.Name,
+			strconv.Itoa(s.Total), strconv.Itoa(s.Executed), strconv.Itoa(s.Passed), strconv.Itoa(s.Failed), strconv.Itoa(s.Other),
+			strconv.FormatFloat(s.PassRate()*100, 'f', 1, 64),
+			strconv.Itoa(s.Defects),
+		})
+	}
+
+	cw.Write(csvHeader)
+	row("total", "", &r.Total)
+	for i := range r.Cycles {
+		c := &r.Cycles[i]
+		row("cycle", c.Name, &c.Summary)
+		for j := range c.Folders {
+			row("folder", c.Name, &c.Folders[j].Summary)
+		}
+	}
+	for i := range r.Components {
+		row("component", "", &r.Components[i])
+	}
+	for i := range r.Executors {
+		row("executor", "", &r.Executors[i])
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,60 @@
+package testreport
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+)
+
+// htmlTemplate renders the report as a single page without external resources
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"percent": func(s Summary) string { return fmt.Sprintf("%.1f%%", s.PassRate()*100) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #172b4d; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #dfe1e6; padding: 4px 8px; text-align: left; }
+th { background: #f4f5f7; }
+td.num { text-align: right; }
+tr.folder td:first-child { padding-left: 2em; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Generated {{.Generated.Format "2006-01-02 15:04 MST"}}.
+{{.Total.Executed}} of {{.Total.Total}} tests executed, {{.Total.Passed}} passed ({{percent .Total}}), {{.Total.Defects}} defects.</p>
+{{define "header"}}<tr><th>{{.}}</th><th>Total</th><th>Executed</th><th>Passed</th><th>Failed</th><th>Other</th><th>Pass rate</th><th>Defects</th></tr>{{end}}
+{{define "row"}}<td class="num">{{.Total}}</td><td class="num">{{.Executed}}</td><td class="num">{{.Passed}}</td><td class="num">{{.Failed}}</td><td class="num">{{.Other}}</td><td class="num">{{percent .}}</td><td class="num">{{.Defects}}</td>{{end}}
+<h2>Cycles</h2>
+<table>
+{{template "header" "Cycle / folder"}}
+{{range .Cycles}}<tr class="cycle"><td><b>{{.Name}}</b></td>{{template "row" .Summary}}</tr>
+{{range .Folders}}<tr class="folder"><td>{{.Name}}</td>{{template "row" .Summary}}</tr>
+{{end}}{{end}}</table>
+{{if .Components}}<h2>Components</h2>
+<table>
+{{template "header" "Component"}}
+{{range .Components}}<tr><td>{{.Name}}</td>{{template "row" .}}</tr>
+{{end}}</table>
+{{end}}{{if .Executors}}<h2>Executed by</h2>
+<table>
+{{template "header" "User"}}
+{{range .Executors}}<tr><td>{{.Name}}</td>{{template "row" .}}</tr>
+{{end}}</table>
+{{end}}{{if .Defects}}<h2>Defects</h2>
+<table>
+<tr><th>Defect</th><th>Summary</th><th>Status</th><th>Tests</th></tr>
+{{range .Defects}}<tr><td>{{.Key}}</td><td>{{.Summary}}</td><td>{{.Status}}</td><td>{{range $i, $k := .IssueKeys}}{{if $i}}, {{end}}{{$k}}{{end}}</td></tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`))
+
+// WriteHTML writes the report as a self-contained HTML page
+func (r *Report) WriteHTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, r)
+}
@@ -0,0 +1,96 @@
+package testreport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	jira "github.com/tya/go-jira"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	ID       int             `xml:"id,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the executions as JUnit XML, one testsuite per cycle.
+// Passed executions are successful test cases and those with a failure status
+// are failures of that type. Unexecuted ones and those with any other status,
+// e.g. WIP or BLOCKED, are skipped with the status as message.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	doc := junitTestSuites{Name: r.Title}
+	suites := make(map[int]int, len(r.Cycles))
+	for _, c := range r.Cycles {
+		suites[c.ID] = len(doc.Suites)
+		doc.Suites = append(doc.Suites, junitTestSuite{Name: c.Name, ID: c.ID})
+	}
+
+	for i := range r.Executions {
+		exe := &r.Executions[i]
+		idx, ok := suites[exe.CycleID]
+		if !ok {
+			continue
+		}
+		suite := &doc.Suites[idx]
+
+		tc := junitTestCase{Name: fmt.Sprintf("%s %s", exe.IssueKey, exe.Summary), ClassName: suite.Name}
+		if exe.FolderName != "" {
+			tc.ClassName += "." + exe.FolderName
+		}
+		status := r.StatusName(exe)
+		switch {
+		case exe.ExecutionStatus == jira.StatusPass.String():
+		case r.failed(exe):
+			tc.Failure = &junitMessage{Message: status, Type: status, Text: exe.Comment}
+			suite.Failures++
+		default:
+			tc.Skipped = &junitMessage{Message: status}
+			suite.Skipped++
+		}
+		suite.Tests++
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	for _, s := range doc.Suites {
+		doc.Tests += s.Tests
+		doc.Failures += s.Failures
+		doc.Skipped += s.Skipped
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
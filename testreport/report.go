@@ -0,0 +1,278 @@
+// Package testreport summarises the Zephyr test runs of a project version and
+// renders the summary as CSV, as a self-contained HTML page or as JUnit XML.
+// It also traces requirements to their tests, executions and defects.
+package testreport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+// Options select the project version a report is collected for
+type Options struct {
+	ProjectID int
+	VersionID int
+	// Title is shown as the heading of the HTML report, defaults to
+	// "Test report " followed by the version name
+	Title string
+	// FailureStatuses are the statuses counted as failed, FAIL if empty
+	FailureStatuses []jira.StatusID
+}
+
+// Summary counts the executions of a cycle, folder, component or executor
+type Summary struct {
+	Name     string
+	Total    int
+	Executed int
+	Passed   int
+	// Failed counts the executions with a failure status
+	Failed int
+	// Other counts the executions with any other executed status, e.g. WIP or BLOCKED
+	Other int
+	// ByStatus counts the executions per status name
+	ByStatus map[string]int
+	// Defects is the number of distinct defects linked to the executions
+	Defects int
+
+	defects map[string]bool
+}
+
+// PassRate returns the passed share of the executed tests, between 0 and 1
+func (s *Summary) PassRate() float64 {
+	if s.Executed == 0 {
+		return 0
+	}
+	return float64(s.Passed) / float64(s.Executed)
+}
+
+// add counts exe, which has the status named status; failed tells whether that is a failure status
+func (s *Summary) add(exe *jira.Execution, status string, failed bool) {
+	s.Total++
+	if s.ByStatus == nil {
+		s.ByStatus = make(map[string]int)
+		s.defects = make(map[string]bool)
+	}
+	s.ByStatus[status]++
+	switch exe.ExecutionStatus {
+	case jira.StatusUnexecuted.String(), "":
+	case jira.StatusPass.String():
+		s.Executed++
+		s.Passed++
+	default:
+		s.Executed++
+		if failed {
+			s.Failed++
+		} else {
+			s.Other++
+		}
+	}
+	for _, d := range exe.Defects {
+		s.defects[d.Key] = true
+	}
+	s.Defects = len(s.defects)
+}
+
+// CycleSummary is the summary of a cycle and its folders
+type CycleSummary struct {
+	Summary
+	ID      int
+	Folders []FolderSummary
+}
+
+// FolderSummary is the summary of a folder within a cycle
+type FolderSummary struct {
+	Summary
+	ID int
+}
+
+// Report is the summary of the test runs of a project version
+type Report struct {
+	Title       string
+	ProjectID   int
+	VersionID   int
+	VersionName string
+	Generated   time.Time
+
+	Total      Summary
+	Cycles     []CycleSummary
+	Components []Summary
+	Executors  []Summary
+	Defects    []jira.CycleDefect
+
+	// Executions are all executions of the version, ordered by cycle, folder and issue key
+	Executions []jira.Execution
+
+	statusNames map[string]string
+	failures    map[string]bool
+}
+
+// StatusName returns the name of the status of exe, e.g. "PASS"
+func (r *Report) StatusName(exe *jira.Execution) string {
+	if name, ok := r.statusNames[exe.ExecutionStatus]; ok {
+		return name
+	}
+	if exe.ExecutionStatus == "" {
+		return r.statusNames[jira.StatusUnexecuted.String()]
+	}
+	return exe.ExecutionStatus
+}
+
+// failed tells whether exe has one of the failure statuses of the report
+func (r *Report) failed(exe *jira.Execution) bool {
+	if r.failures == nil {
+		return exe.ExecutionStatus == jira.StatusFail.String()
+	}
+	return r.failures[exe.ExecutionStatus]
+}
+
+// Collect wraps CollectWithContext using the background context
+func Collect(client *jira.Client, opts *Options) (*Report, error) {
+	return CollectWithContext(context.Background(), client, opts)
+}
+
+// CollectWithContext pulls the cycles, folders and executions of a project
+// version and summarises them
+func CollectWithContext(ctx context.Context, client *jira.Client, opts *Options) (*Report, error) {
+	if opts == nil {
+		return nil, errors.New("testreport: no options given")
+	}
+	catalogue, _, err := client.Execution.GetStatusCatalogueWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cycles, _, err := client.Cycle.GetListWithContext(ctx, &jira.CycleListOptions{ProjectID: opts.ProjectID, VersionID: opts.VersionID})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i].Name < cycles[j].Name })
+
+	r := &Report{
+		Title:       opts.Title,
+		ProjectID:   opts.ProjectID,
+		VersionID:   opts.VersionID,
+		Generated:   time.Now(),
+		Total:       Summary{Name: "Total"},
+		statusNames: make(map[string]string),
+		failures:    map[string]bool{jira.StatusFail.String(): true},
+	}
+	for _, s := range catalogue.Execution {
+		r.statusNames[s.ID.String()] = s.Name
+	}
+	if len(opts.FailureStatuses) > 0 {
+		r.failures = make(map[string]bool, len(opts.FailureStatuses))
+		for _, id := range opts.FailureStatuses {
+			r.failures[id.String()] = true
+		}
+	}
+
+	components := make(map[string]*Summary)
+	executors := make(map[string]*Summary)
+	defects := make(map[string]*jira.CycleDefect)
+	for _, cycle := range cycles {
+		if r.VersionName == "" {
+			r.VersionName = cycle.VersionName
+		}
+		cs := CycleSummary{Summary: Summary{Name: cycle.Name}, ID: cycle.ID}
+
+		folders, _, err := client.Folder.GetListWithContext(ctx, cycle.ID, &jira.FolderListOptions{ProjectID: opts.ProjectID, VersionID: opts.VersionID})
+		if err != nil {
+			return nil, err
+		}
+		byFolder := make(map[int]int, len(folders))
+		for _, f := range folders {
+			byFolder[f.ID] = len(cs.Folders)
+			cs.Folders = append(cs.Folders, FolderSummary{Summary: Summary{Name: f.Name}, ID: f.ID})
+		}
+
+		exes, _, err := client.Execution.GetAllWithContext(ctx, &jira.ExecutionListOptions{ProjectID: opts.ProjectID, VersionID: opts.VersionID, CycleID: cycle.ID})
+		if err != nil {
+			return nil, err
+		}
+		for i := range exes {
+			exe := &exes[i]
+			status, failed := r.StatusName(exe), r.failed(exe)
+			r.Total.add(exe, status, failed)
+			cs.add(exe, status, failed)
+
+			if exe.FolderID != 0 {
+				idx, ok := byFolder[exe.FolderID]
+				if !ok {
+					idx = len(cs.Folders)
+					byFolder[exe.FolderID] = idx
+					cs.Folders = append(cs.Folders, FolderSummary{Summary: Summary{Name: exe.FolderName}, ID: exe.FolderID})
+				}
+				cs.Folders[idx].add(exe, status, failed)
+			}
+			for _, c := range strings.Split(exe.Component, ",") {
+				if c = strings.TrimSpace(c); c != "" {
+					summary(components, c).add(exe, status, failed)
+				}
+			}
+			if by := executor(exe); by != "" {
+				summary(executors, by).add(exe, status, failed)
+			}
+			for _, d := range exe.Defects {
+				cd, ok := defects[d.Key]
+				if !ok {
+					cd = &jira.CycleDefect{Defect: d}
+					defects[d.Key] = cd
+				}
+				cd.ExecutionIDs = append(cd.ExecutionIDs, exe.ID)
+				cd.IssueKeys = append(cd.IssueKeys, exe.IssueKey)
+			}
+		}
+		sort.SliceStable(exes, func(i, j int) bool {
+			if exes[i].FolderName != exes[j].FolderName {
+				return exes[i].FolderName < exes[j].FolderName
+			}
+			return exes[i].IssueKey < exes[j].IssueKey
+		})
+		r.Executions = append(r.Executions, exes...)
+
+		sort.Slice(cs.Folders, func(i, j int) bool { return cs.Folders[i].Name < cs.Folders[j].Name })
+		r.Cycles = append(r.Cycles, cs)
+	}
+
+	r.Components = sorted(components)
+	r.Executors = sorted(executors)
+	for _, cd := range defects {
+		r.Defects = append(r.Defects, *cd)
+	}
+	sort.Slice(r.Defects, func(i, j int) bool { return r.Defects[i].Key < r.Defects[j].Key })
+	if r.Title == "" {
+		r.Title = fmt.Sprintf("Test report %s", r.VersionName)
+	}
+	return r, nil
+}
+
+// executor returns the display name of the user that executed exe, if any
+func executor(exe *jira.Execution) string {
+	if exe.ExecutedByDisplay != "" {
+		return exe.ExecutedByDisplay
+	}
+	return exe.ExecutedBy
+}
+
+func summary(m map[string]*Summary, name string) *Summary {
+	s, ok := m[name]
+	if !ok {
+		s = &Summary{Name: name}
+		m[name] = s
+	}
+	return s
+}
+
+func sorted(m map[string]*Summary) []Summary {
+	list := make([]Summary, 0, len(m))
+	for _, s := range m {
+		list = append(list, *s)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
@@ -0,0 +1,190 @@
+package testreport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	jira "github.com/tya/go-jira"
+)
+
+// newZephyrServer fakes the ZAPI endpoints read by Collect. Version 10001 has
+// the cycles "Nightly" (id 100, folders Login and Empty) and "Smoke" (id 200).
+func newZephyrServer(t *testing.T) (*jira.Client, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/rest/zapi/latest/util/testExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": -1, "name": "UNEXECUTED"}, {"id": 1, "name": "PASS"}, {"id": 2, "name": "FAIL"}, {"id": 4, "name": "BLOCKED"}]`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/util/teststepExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/cycle", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"200": {"name": "Smoke", "versionName": "2.0"}, "100": {"name": "Nightly", "versionName": "2.0"}, "recordsCount": 2}`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/cycle/100/folders", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"folderId": 11, "folderName": "Login"}, {"folderId": 12, "folderName": "Empty"}]`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/cycle/200/folders", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/execution", func(w http.ResponseWriter, r *http.Request) {
+		files := map[string]string{"100": "testdata/executions_nightly.json", "200": "testdata/executions_smoke.json"}
+		raw, err := ioutil.ReadFile(files[r.URL.Query().Get("cycleId")])
+		if err != nil {
+			t.Error(err)
+		}
+		w.Write(raw)
+	})
+
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.Close
+}
+
+func collect(t *testing.T) *Report {
+	return collectWith(t, &Options{ProjectID: 10000, VersionID: 10001})
+}
+
+func collectWith(t *testing.T, opts *Options) *Report {
+	client, teardown := newZephyrServer(t)
+	defer teardown()
+
+	r, err := Collect(client, opts)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	r.Generated = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	return r
+}
+
+func TestCollect(t *testing.T) {
+	r := collect(t)
+
+	if r.Title != "Test report 2.0" {
+		t.Errorf("Expected title %q but got %q", "Test report 2.0", r.Title)
+	}
+	if r.Total.Total != 4 || r.Total.Executed != 3 || r.Total.Passed != 1 || r.Total.Failed != 1 || r.Total.Other != 1 {
+		t.Errorf("Unexpected total %+v", r.Total)
+	}
+	if r.Total.Defects != 1 {
+		t.Errorf("Expected 1 distinct defect but got %d", r.Total.Defects)
+	}
+	if len(r.Cycles) != 2 || r.Cycles[0].Name != "Nightly" {
+		t.Fatalf("Expected cycles Nightly and Smoke, got %+v", r.Cycles)
+	}
+	nightly := r.Cycles[0]
+	if got := nightly.PassRate(); got != 0.5 {
+		t.Errorf("Expected Nightly pass rate 0.5 but got %v", got)
+	}
+	if len(nightly.Folders) != 2 || nightly.Folders[0].Name != "Empty" || nightly.Folders[1].Total != 2 {
+		t.Errorf("Unexpected folders %+v", nightly.Folders)
+	}
+	if len(r.Components) != 2 || r.Components[0].Name != "Auth" || r.Components[0].Total != 2 || r.Components[1].Total != 2 {
+		t.Errorf("Unexpected components %+v", r.Components)
+	}
+	if len(r.Executors) != 2 || r.Executors[0].Name != "Jane Doe" || r.Executors[1].Name != "rroe" {
+		t.Errorf("Unexpected executors %+v", r.Executors)
+	}
+	if len(r.Defects) != 1 || len(r.Defects[0].IssueKeys) != 2 {
+		t.Errorf("Expected SAM-40 linked to two executions, got %+v", r.Defects)
+	}
+}
+
+func TestCollect_FailureStatuses(t *testing.T) {
+	r := collectWith(t, &Options{ProjectID: 10000, VersionID: 10001, FailureStatuses: []jira.StatusID{jira.StatusFail, jira.StatusBlocked}})
+
+	if r.Total.Failed != 2 || r.Total.Other != 0 {
+		t.Errorf("Expected BLOCKED to count as failed, got %+v", r.Total)
+	}
+}
+
+func TestCollect_NoOptions(t *testing.T) {
+	if _, err := Collect(nil, nil); err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestSummary_WIP(t *testing.T) {
+	r := &Report{statusNames: map[string]string{"3": "WIP"}}
+	exe := &jira.Execution{ExecutionStatus: jira.StatusWIP.String()}
+
+	s := Summary{}
+	s.add(exe, r.StatusName(exe), r.failed(exe))
+	if s.Executed != 1 || s.Failed != 0 || s.Other != 1 || s.ByStatus["WIP"] != 1 {
+		t.Errorf("Expected WIP to be executed but not failed, got %+v", s)
+	}
+}
+
+func TestReport_WriteCSV(t *testing.T) {
+	r := collect(t)
+
+	var out bytes.Buffer
+	if err := r.WriteCSV(&out); err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	rows, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// header, total, 2 cycles, 2 folders, 2 components, 2 executors
+	if len(rows) != 10 {
+		t.Fatalf("Expected %d rows but got %d", 10, len(rows))
+	}
+	want := "cycle,Nightly,Nightly,3,2,1,1,0,50.0,1"
+	if got := strings.Join(rows[2], ","); got != want {
+		t.Errorf("Expected row %q but got %q", want, got)
+	}
+}
+
+func TestReport_WriteJUnit(t *testing.T) {
+	r := collect(t)
+
+	var out bytes.Buffer
+	if err := r.WriteJUnit(&out); err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	var doc junitTestSuites
+	if err := xml.Unmarshal(out.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Tests != 4 || doc.Failures != 1 || doc.Skipped != 2 {
+		t.Errorf("Unexpected totals: %d tests, %d failures, %d skipped", doc.Tests, doc.Failures, doc.Skipped)
+	}
+	failure := doc.Suites[0].Cases[2].Failure
+	if failure == nil || failure.Type != "FAIL" || failure.Text != "expected 401" {
+		t.Errorf("Expected SAM-15 to fail with comment, got %+v", doc.Suites[0].Cases[2])
+	}
+	if c := doc.Suites[1].Cases[0]; c.Failure != nil || c.Skipped == nil || c.Skipped.Message != "BLOCKED" {
+		t.Errorf("Expected blocked execution to be skipped, got %+v", c)
+	}
+}
+
+func TestReport_WriteHTML(t *testing.T) {
+	r := collect(t)
+	r.Cycles[0].Name = "<Nightly>"
+
+	var out bytes.Buffer
+	if err := r.WriteHTML(&out); err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	html := out.String()
+	for _, want := range []string{"<title>Test report 2.0</title>", "&lt;Nightly&gt;", "SAM-40", "Jane Doe", "33.3%"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected HTML to contain %q", want)
+		}
+	}
+	if strings.Contains(html, "<link") || strings.Contains(html, "<script") {
+		t.Error("Expected HTML without external resources")
+	}
+}
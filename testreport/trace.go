@@ -0,0 +1,244 @@
+package testreport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	jira "github.com/tya/go-jira"
+)
+
+// DefaultTestIssueType is the issue type of Zephyr test issues
+const DefaultTestIssueType = "Test"
+
+// TraceOptions select the requirements of a traceability matrix and how
+// their tests are found
+type TraceOptions struct {
+	// JQL selects the requirement issues, e.g. `project = SAM AND issuetype = Story`
+	JQL string
+	// LinkType is the issue link type between requirements and tests, matched
+	// by ID if it has one, by name otherwise. Links of both directions count.
+	LinkType *jira.IssueLinkType
+	// TestIssueType restricts linked issues to tests, defaults to DefaultTestIssueType
+	TestIssueType string
+	// VersionID restricts executions to a single version, all versions if 0
+	VersionID int
+}
+
+// TraceMatrix links requirements to their tests, the latest executions of
+// those tests and the defects raised from them
+type TraceMatrix struct {
+	Requirements []RequirementTrace
+}
+
+// RequirementTrace is a requirement issue with the tests covering it
+type RequirementTrace struct {
+	Key     string
+	Summary string
+	Status  string
+	Tests   []TestTrace
+}
+
+// Covered reports whether at least one test is linked to the requirement
+func (r *RequirementTrace) Covered() bool {
+	return len(r.Tests) > 0
+}
+
+// TestTrace is a test issue with its latest execution per version
+type TestTrace struct {
+	Key     string
+	Summary string
+	// Executions hold the latest execution per version, ordered by version
+	Executions []TraceExecution
+	// Defects are the distinct defects linked to those executions
+	Defects []jira.Defect
+}
+
+// TraceExecution is the latest execution of a test in a version
+type TraceExecution struct {
+	jira.Execution
+	// Status is the name of the execution status, e.g. "PASS"
+	Status string
+}
+
+// Uncovered returns the requirements without tests
+func (m *TraceMatrix) Uncovered() []RequirementTrace {
+	var list []RequirementTrace
+	for _, r := range m.Requirements {
+		if !r.Covered() {
+			list = append(list, r)
+		}
+	}
+	return list
+}
+
+// Trace wraps TraceWithContext using the background context
+func Trace(client *jira.Client, opts *TraceOptions) (*TraceMatrix, error) {
+	return TraceWithContext(context.Background(), client, opts)
+}
+
+// TraceWithContext builds the traceability matrix of the requirements selected
+// by opts.JQL. Tests are found through the requirements' issue links, their
+// executions through the ExecutionService.
+func TraceWithContext(ctx context.Context, client *jira.Client, opts *TraceOptions) (*TraceMatrix, error) {
+	if opts == nil {
+		return nil, errors.New("testreport: no trace options given")
+	}
+	if opts.LinkType == nil {
+		return nil, errors.New("testreport: no link type given")
+	}
+	testType := opts.TestIssueType
+	if testType == "" {
+		testType = DefaultTestIssueType
+	}
+
+	catalogue, _, err := client.Execution.GetStatusCatalogueWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statusNames := make(map[string]string)
+	for _, s := range catalogue.Execution {
+		statusNames[s.ID.String()] = s.Name
+	}
+
+	m := new(TraceMatrix)
+	tests := make(map[string]*TestTrace)
+	search := &jira.SearchOptions{MaxResults: 50, Fields: []string{"summary", "status", "issuelinks"}}
+	err = client.Issue.SearchPagesWithContext(ctx, opts.JQL, search, func(issue jira.Issue) error {
+		rt := RequirementTrace{Key: issue.Key}
+		if issue.Fields == nil {
+			m.Requirements = append(m.Requirements, rt)
+			return nil
+		}
+		rt.Summary = issue.Fields.Summary
+		if issue.Fields.Status != nil {
+			rt.Status = issue.Fields.Status.Name
+		}
+
+		seen := make(map[string]bool)
+		for _, link := range issue.Fields.IssueLinks {
+			test := linkedTest(link, opts.LinkType, testType)
+			if test == nil || seen[test.Key] {
+				continue
+			}
+			seen[test.Key] = true
+
+			tt, ok := tests[test.Key]
+			if !ok {
+				tt, err = traceTest(ctx, client, test, opts.VersionID, statusNames)
+				if err != nil {
+					return err
+				}
+				tests[test.Key] = tt
+			}
+			rt.Tests = append(rt.Tests, *tt)
+		}
+		sort.Slice(rt.Tests, func(i, j int) bool { return rt.Tests[i].Key < rt.Tests[j].Key })
+		m.Requirements = append(m.Requirements, rt)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// linkedTest returns the test issue at the other end of link, or nil if link
+// is not of linkType or does not lead to an issue of type testType
+func linkedTest(link *jira.IssueLink, linkType *jira.IssueLinkType, testType string) *jira.Issue {
+	if linkType.ID != "" {
+		if link.Type.ID != linkType.ID {
+			return nil
+		}
+	} else if !strings.EqualFold(link.Type.Name, linkType.Name) {
+		return nil
+	}
+
+	issue := link.OutwardIssue
+	if issue == nil {
+		issue = link.InwardIssue
+	}
+	if issue == nil || issue.Fields == nil || !strings.EqualFold(issue.Fields.Type.Name, testType) {
+		return nil
+	}
+	return issue
+}
+
+// traceTest gets the executions of test and keeps the latest one per version
+func traceTest(ctx context.Context, client *jira.Client, test *jira.Issue, versionID int, statusNames map[string]string) (*TestTrace, error) {
+	tt := &TestTrace{Key: test.Key, Summary: test.Fields.Summary}
+	issueID, err := strconv.Atoi(test.ID)
+	if err != nil {
+		return nil, fmt.Errorf("testreport: test %s has no numeric id: %w", test.Key, err)
+	}
+
+	exes, _, err := client.Execution.GetAllWithContext(ctx, &jira.ExecutionListOptions{IssueID: issueID, VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[int]jira.Execution)
+	for _, exe := range exes {
+		cur, ok := latest[exe.VersionID]
+		if !ok || exe.ExecutedOnVal > cur.ExecutedOnVal || (exe.ExecutedOnVal == cur.ExecutedOnVal && exe.ID > cur.ID) {
+			latest[exe.VersionID] = exe
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, exe := range latest {
+		status, ok := statusNames[exe.ExecutionStatus]
+		if !ok {
+			status = exe.ExecutionStatus
+		}
+		tt.Executions = append(tt.Executions, TraceExecution{Execution: exe, Status: status})
+		for _, d := range exe.Defects {
+			if !seen[d.Key] {
+				seen[d.Key] = true
+				tt.Defects = append(tt.Defects, d)
+			}
+		}
+	}
+	sort.Slice(tt.Executions, func(i, j int) bool { return tt.Executions[i].VersionID < tt.Executions[j].VersionID })
+	sort.Slice(tt.Defects, func(i, j int) bool { return tt.Defects[i].Key < tt.Defects[j].Key })
+	return tt, nil
+}
+
+// traceHeader are the columns written by TraceMatrix.WriteCSV
+var traceHeader = []string{"requirement", "requirement_summary", "requirement_status", "test", "test_summary", "version", "cycle", "status", "executed_on", "executed_by", "defects"}
+
+// WriteCSV writes one row per requirement, test and version. Requirements
+// without tests and tests without executions get a row with the columns
+// to the right left empty.
+func (m *TraceMatrix) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(traceHeader)
+	for _, r := range m.Requirements {
+		req := []string{r.Key, r.Summary, r.Status}
+		if !r.Covered() {
+			cw.Write(append(req, "", "", "", "", "", "", "", ""))
+			continue
+		}
+		for _, t := range r.Tests {
+			test := append(append([]string{}, req...), t.Key, t.Summary)
+			if len(t.Executions) == 0 {
+				cw.Write(append(test, "", "", "", "", "", ""))
+				continue
+			}
+			for _, e := range t.Executions {
+				keys := make([]string, len(e.Defects))
+				for i, d := range e.Defects {
+					keys[i] = d.Key
+				}
+				cw.Write(append(append([]string{}, test...),
+					e.VersionName, e.CycleName, e.Status, e.ExecutedOn, executor(&e.Execution), strings.Join(keys, " ")))
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,138 @@
+package testreport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/tya/go-jira"
+)
+
+func newTraceServer(t *testing.T) (*jira.Client, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/rest/zapi/latest/util/testExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": -1, "name": "UNEXECUTED"}, {"id": 1, "name": "PASS"}, {"id": 2, "name": "FAIL"}]`)
+	})
+	mux.HandleFunc("/rest/zapi/latest/util/teststepExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		raw, err := ioutil.ReadFile("testdata/requirements.json")
+		if err != nil {
+			t.Error(err)
+		}
+		w.Write(raw)
+	})
+	mux.HandleFunc("/rest/zapi/latest/execution", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("issueId") {
+		case "10013":
+			fmt.Fprint(w, `{"executions": [
+				{"id": 1, "executionStatus": "2", "versionId": 10001, "versionName": "1.0", "executedOnVal": 1000, "defects": [{"key": "SAM-40"}]},
+				{"id": 2, "executionStatus": "1", "versionId": 10001, "versionName": "1.0", "cycleName": "Nightly", "executedOnVal": 2000, "executedOn": "02/Jun/20", "executedByDisplay": "Jane Doe"},
+				{"id": 3, "executionStatus": "2", "versionId": 10002, "versionName": "2.0", "executedOnVal": 1500, "defects": [{"key": "SAM-41"}]}
+			]}`)
+		default:
+			fmt.Fprint(w, `{"executions": []}`)
+		}
+	})
+
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.Close
+}
+
+func TestTrace_Links(t *testing.T) {
+	client, teardown := newTraceServer(t)
+	defer teardown()
+
+	m, err := Trace(client, &TraceOptions{JQL: "project = SAM", LinkType: &jira.IssueLinkType{Name: "tests"}})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(m.Requirements) != 2 {
+		t.Fatalf("Expected 2 requirements but got %d", len(m.Requirements))
+	}
+	sam1 := m.Requirements[0]
+	if len(sam1.Tests) != 2 || sam1.Tests[0].Key != "SAM-14" || sam1.Tests[1].Key != "SAM-15" {
+		t.Fatalf("Expected tests SAM-14 and SAM-15, got %+v", sam1.Tests)
+	}
+	sam14 := sam1.Tests[0]
+	if len(sam14.Executions) != 2 {
+		t.Fatalf("Expected latest executions of 2 versions but got %d", len(sam14.Executions))
+	}
+	if e := sam14.Executions[0]; e.ID != 2 || e.Status != "PASS" {
+		t.Errorf("Expected execution 2 to be the latest of 1.0, got %d (%s)", e.ID, e.Status)
+	}
+	if len(sam14.Defects) != 1 || sam14.Defects[0].Key != "SAM-41" {
+		t.Errorf("Expected only the defect of the latest executions, got %+v", sam14.Defects)
+	}
+	if uncovered := m.Uncovered(); len(uncovered) != 1 || uncovered[0].Key != "SAM-2" {
+		t.Errorf("Expected SAM-2 to be uncovered, got %+v", uncovered)
+	}
+}
+
+func TestTrace_LinkTypeByID(t *testing.T) {
+	client, teardown := newTraceServer(t)
+	defer teardown()
+
+	m, err := Trace(client, &TraceOptions{JQL: "project = SAM", LinkType: &jira.IssueLinkType{ID: "10000"}})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if tests := m.Requirements[0].Tests; len(tests) != 1 || tests[0].Key != "SAM-17" {
+		t.Errorf("Expected SAM-17 linked by Blocks, got %+v", tests)
+	}
+}
+
+func TestTrace_NoLinkType(t *testing.T) {
+	if _, err := Trace(nil, &TraceOptions{JQL: "project = SAM"}); err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestTrace_NoOptions(t *testing.T) {
+	if _, err := Trace(nil, nil); err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestTraceMatrix_WriteCSV(t *testing.T) {
+	client, teardown := newTraceServer(t)
+	defer teardown()
+
+	m, err := Trace(client, &TraceOptions{JQL: "project = SAM", LinkType: &jira.IssueLinkType{Name: "Tests"}})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	var out bytes.Buffer
+	if err := m.WriteCSV(&out); err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	rows, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// header, SAM-14 in two versions, SAM-15 without executions, uncovered SAM-2
+	if len(rows) != 5 {
+		t.Fatalf("Expected %d rows but got %d", 5, len(rows))
+	}
+	want := "SAM-1,Users can log in,Done,SAM-14,Login works,1.0,Nightly,PASS,02/Jun/20,Jane Doe,"
+	if got := strings.Join(rows[1], ","); got != want {
+		t.Errorf("Expected row %q but got %q", want, got)
+	}
+	if rows[3][3] != "SAM-15" || rows[3][5] != "" {
+		t.Errorf("Expected SAM-15 without execution, got %v", rows[3])
+	}
+	if rows[4][0] != "SAM-2" || rows[4][3] != "" {
+		t.Errorf("Expected uncovered SAM-2, got %v", rows[4])
+	}
+}
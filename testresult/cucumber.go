@@ -0,0 +1,128 @@
+package testresult
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type cucumberFeature struct {
+	URI      string            `json:"uri"`
+	Name     string            `json:"name"`
+	Tags     []cucumberTag     `json:"tags"`
+	Elements []cucumberElement `json:"elements"`
+}
+
+type cucumberElement struct {
+	Name   string         `json:"name"`
+	Type   string         `json:"type"`
+	Tags   []cucumberTag  `json:"tags"`
+	Before []cucumberStep `json:"before"`
+	Steps  []cucumberStep `json:"steps"`
+	After  []cucumberStep `json:"after"`
+}
+
+type cucumberTag struct {
+	Name string `json:"name"`
+}
+
+type cucumberStep struct {
+	Keyword string         `json:"keyword"`
+	Name    string         `json:"name"`
+	Result  cucumberResult `json:"result"`
+}
+
+type cucumberResult struct {
+	Status       string `json:"status"`
+	Duration     int64  `json:"duration"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// ParseCucumber reads a Cucumber JSON report.
+// Every scenario becomes a Result, its tags include the tags of the feature.
+// Background steps are accounted to the scenario that follows them.
+func ParseCucumber(r io.Reader) ([]Result, error) {
+	var features []cucumberFeature
+	if err := json.NewDecoder(r).Decode(&features); err != nil {
+		return nil, fmt.Errorf("cucumber: %w", err)
+	}
+
+	var results []Result
+	for _, f := range features {
+		var background []cucumberStep
+		for _, e := range f.Elements {
+			if e.Type == "background" {
+				background = e.Steps
+				continue
+			}
+			results = append(results, e.result(f, background))
+			background = nil
+		}
+	}
+	return results, nil
+}
+
+func (e cucumberElement) result(f cucumberFeature, background []cucumberStep) Result {
+	r := Result{
+		Suite:   f.Name,
+		Name:    e.Name,
+		Outcome: Passed,
+		Tags:    tagNames(f.Tags, e.Tags),
+	}
+
+	var steps []cucumberStep
+	steps = append(steps, e.Before...)
+	steps = append(steps, background...)
+	steps = append(steps, e.Steps...)
+	steps = append(steps, e.After...)
+	for _, s := range steps {
+		r.Duration += time.Duration(s.Result.Duration)
+
+		outcome := cucumberOutcome(s.Result.Status)
+		if severity[outcome] <= severity[r.Outcome] {
+			continue
+		}
+		r.Outcome = outcome
+		r.Details = strings.TrimSpace(s.Result.ErrorMessage)
+		r.Message = strings.TrimSpace(s.Keyword + s.Name)
+		if r.Message == "" {
+			r.Message = "hook " + s.Result.Status
+		} else {
+			r.Message += " " + s.Result.Status
+		}
+	}
+	return r
+}
+
+// cucumberOutcome maps a step status to an Outcome.
+// Pending and undefined steps mean the scenario was not really run.
+func cucumberOutcome(status string) Outcome {
+	switch status {
+	case "passed":
+		return Passed
+	case "failed":
+		return Failed
+	case "ambiguous":
+		return Errored
+	default:
+		return Skipped
+	}
+}
+
+// tagNames merges tag lists, dropping the leading "@" and duplicates
+func tagNames(lists ...[]cucumberTag) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, tags := range lists {
+		for _, t := range tags {
+			name := strings.TrimPrefix(t.Name, "@")
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,60 @@
+package testresult
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCucumber(t *testing.T) {
+	f, err := os.Open("./testdata/cucumber.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	results, err := ParseCucumber(f)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Expected %d results but got %d", 3, len(results))
+	}
+
+	valid := results[0]
+	if valid.Outcome != Passed || valid.Suite != "Login" {
+		t.Errorf("Unexpected first scenario %+v", valid)
+	}
+	if valid.Duration != 6*time.Millisecond {
+		t.Errorf("Expected background to be included in duration, got %v", valid.Duration)
+	}
+	if k := issueKey(valid, DefaultKeyProperties, DefaultKeyPattern); k != "SAM-14" {
+		t.Errorf("Expected tag key SAM-14 but got %q", k)
+	}
+
+	invalid := results[1]
+	if invalid.Outcome != Failed {
+		t.Errorf("Expected failed scenario, got %s", invalid.Outcome)
+	}
+	if invalid.Message != "Then I see an error failed" || !strings.Contains(invalid.Details, "steps.js:10") {
+		t.Errorf("Unexpected failure %q / %q", invalid.Message, invalid.Details)
+	}
+	if k := issueKey(invalid, DefaultKeyProperties, DefaultKeyPattern); k != "SAM-15" {
+		t.Errorf("Expected tag key SAM-15 but got %q", k)
+	}
+	if len(invalid.Tags) != 2 || invalid.Tags[0] != "auth" {
+		t.Errorf("Expected feature tags to be inherited, got %v", invalid.Tags)
+	}
+
+	if results[2].Outcome != Skipped {
+		t.Errorf("Expected undefined scenario to be skipped, got %s", results[2].Outcome)
+	}
+}
+
+func TestParseCucumber_InvalidJSON(t *testing.T) {
+	_, err := ParseCucumber(strings.NewReader(`{"not": "a list"}`))
+	if err == nil {
+		t.Error("No error given")
+	}
+}
@@ -0,0 +1,93 @@
+package testresult
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// goTestEvent is a single line of `go test -json` (test2json) output
+type goTestEvent struct {
+	Action  string  `json:"Action"`
+	Package string  `json:"Package"`
+	Test    string  `json:"Test"`
+	Output  string  `json:"Output"`
+	Elapsed float64 `json:"Elapsed"`
+}
+
+// ParseGoTest reads the output of `go test -json`.
+// Every test and subtest that finished becomes a Result, its Suite is the package.
+// Lines that are not JSON (e.g. build output) are ignored.
+func ParseGoTest(r io.Reader) ([]Result, error) {
+	type testID struct{ pkg, name string }
+	output := make(map[testID][]string)
+
+	var results []Result
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+		var ev goTestEvent
+		if err := json.Unmarshal(line, &ev); err != nil {
+			return nil, fmt.Errorf("gotest: %w", err)
+		}
+		if ev.Test == "" {
+			continue
+		}
+
+		id := testID{ev.Package, ev.Test}
+		var outcome Outcome
+		switch ev.Action {
+		case "output":
+			output[id] = append(output[id], ev.Output)
+			continue
+		case "pass":
+			outcome = Passed
+		case "fail":
+			outcome = Failed
+		case "skip":
+			outcome = Skipped
+		default:
+			continue
+		}
+
+		res := Result{
+			Suite:    ev.Package,
+			Name:     ev.Test,
+			Outcome:  outcome,
+			Duration: time.Duration(ev.Elapsed * float64(time.Second)),
+		}
+		if outcome != Passed {
+			res.Message, res.Details = goTestMessage(output[id])
+		}
+		delete(output, id)
+		results = append(results, res)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("gotest: %w", err)
+	}
+	return results, nil
+}
+
+// goTestMessage extracts the first logged line of a test as message and the
+// complete log as details, leaving out the === and --- status lines
+func goTestMessage(lines []string) (message, details string) {
+	var b strings.Builder
+	for _, l := range lines {
+		trimmed := strings.TrimSpace(l)
+		if strings.HasPrefix(trimmed, "=== ") || strings.HasPrefix(trimmed, "--- ") || trimmed == "" {
+			continue
+		}
+		if message == "" {
+			message = trimmed
+		}
+		b.WriteString(l)
+	}
+	return message, strings.TrimSpace(b.String())
+}
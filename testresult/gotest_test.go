@@ -0,0 +1,58 @@
+package testresult
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestParseGoTest(t *testing.T) {
+	f, err := os.Open("./testdata/gotest.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	results, err := ParseGoTest(f)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("Expected %d results but got %d", 4, len(results))
+	}
+
+	want := []struct {
+		name    string
+		outcome Outcome
+		key     string
+	}{
+		{"TestLogin/SAM-14_valid", Passed, "SAM-14"},
+		{"TestLogin/SAM-15_bad_password", Failed, "SAM-15"},
+		{"TestLogin", Failed, ""},
+		{"TestSSO", Skipped, ""},
+	}
+	for i, w := range want {
+		r := results[i]
+		if r.Name != w.name || r.Outcome != w.outcome {
+			t.Errorf("Result %d: expected %s %s but got %s %s", i, w.name, w.outcome, r.Name, r.Outcome)
+		}
+		if k := issueKey(r, DefaultKeyProperties, DefaultKeyPattern); k != w.key {
+			t.Errorf("Result %d: expected key %q but got %q", i, w.key, k)
+		}
+	}
+	if results[1].Message != "login_test.go:42: expected 401 but got 500" {
+		t.Errorf("Unexpected failure message %q", results[1].Message)
+	}
+	if results[1].Duration != 200*time.Millisecond {
+		t.Errorf("Expected duration 200ms but got %v", results[1].Duration)
+	}
+	if results[3].Message != "sso_test.go:9: sso not configured" {
+		t.Errorf("Unexpected skip message %q", results[3].Message)
+	}
+}
+
+func TestParse_UnknownFormat(t *testing.T) {
+	if _, err := Parse("tap", nil); err == nil {
+		t.Error("No error given")
+	}
+}
@@ -0,0 +1,454 @@
+package testresult
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	jira "github.com/tya/go-jira"
+)
+
+// maxCommentLength is the longest comment Zephyr accepts on an execution
+const maxCommentLength = 750
+
+// DefaultStatuses maps outcomes to the name of the Zephyr status they are recorded with
+var DefaultStatuses = map[Outcome]string{
+	Passed:  "PASS",
+	Failed:  "FAIL",
+	Errored: "FAIL",
+	Skipped: "BLOCKED",
+}
+
+// ImportOptions configures where and how results are recorded
+type ImportOptions struct {
+	ProjectID int
+	// VersionID of the cycle. Zephyr uses -1 for "Unscheduled".
+	VersionID int
+	// CycleName is the cycle the executions are recorded in, created if missing
+	CycleName string
+	// FolderName is an optional folder within the cycle, created if missing
+	FolderName string
+
+	// KeyProperties are the test case properties holding an issue key.
+	// DefaultKeyProperties is used if nil.
+	KeyProperties []string
+	// KeyPattern finds issue keys in properties and test names.
+	// DefaultKeyPattern is used if nil.
+	KeyPattern *regexp.Regexp
+	// MatchByName looks up unannotated tests by issue summary within ProjectKey
+	MatchByName bool
+	ProjectKey  string
+
+	// Statuses overrides DefaultStatuses. Values are status names or IDs,
+	// custom statuses of the instance may be used.
+	Statuses map[Outcome]string
+	// Assignee is set on every execution if not empty
+	Assignee string
+	// DryRun resolves everything but creates and updates nothing
+	DryRun bool
+}
+
+// Action describes what the importer did (or would do) for a test issue
+type Action string
+
+// Actions reported for each entry
+const (
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionUnmapped Action = "unmapped"
+	// ActionError marks an entry that was mapped to an issue key, but
+	// could not be recorded because the issue was not found
+	ActionError Action = "error"
+)
+
+// ReportEntry is the outcome of importing the results for one test issue
+type ReportEntry struct {
+	IssueKey    string
+	ExecutionID int
+	Action      Action
+	Outcome     Outcome
+	// Status is the name of the Zephyr status the outcome is recorded with
+	Status   string
+	StatusID jira.StatusID
+	Results  []Result
+	Err      error
+}
+
+// Report summarises an import
+type Report struct {
+	DryRun        bool
+	CycleID       int
+	CycleName     string
+	CycleCreated  bool
+	FolderID      int
+	FolderName    string
+	FolderCreated bool
+	Entries       []ReportEntry
+}
+
+// Errors returns the entries that could not be recorded
+func (r *Report) Errors() []ReportEntry {
+	var entries []ReportEntry
+	for _, e := range r.Entries {
+		if e.Err != nil {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// WriteTo writes a human readable version of the report to w
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+	if r.DryRun {
+		b.WriteString("DRY RUN - nothing has been changed\n")
+	}
+	fmt.Fprintf(&b, "cycle %q (%s)\n", r.CycleName, createdOrExisting(r.CycleCreated, r.CycleID))
+	if r.FolderName != "" {
+		fmt.Fprintf(&b, "folder %q (%s)\n", r.FolderName, createdOrExisting(r.FolderCreated, r.FolderID))
+	}
+	for _, e := range r.Entries {
+		switch {
+		case e.Action == ActionUnmapped:
+			fmt.Fprintf(&b, "  %-8s %s\n", e.Action, e.Results[0].FullName())
+		case e.Err != nil:
+			fmt.Fprintf(&b, "  %-8s %s %s: %v\n", e.Action, e.IssueKey, e.Outcome, e.Err)
+		default:
+			fmt.Fprintf(&b, "  %-8s %s %s (%d results)\n", e.Action, e.IssueKey, e.Outcome, len(e.Results))
+		}
+	}
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func createdOrExisting(created bool, id int) string {
+	if created {
+		return "new"
+	}
+	return "id " + strconv.Itoa(id)
+}
+
+// Importer records test results as Zephyr executions
+type Importer struct {
+	client *jira.Client
+	opts   ImportOptions
+	issues map[string]int
+}
+
+// NewImporter returns an Importer recording results through client
+func NewImporter(client *jira.Client, opts *ImportOptions) *Importer {
+	im := &Importer{client: client, issues: make(map[string]int)}
+	if opts != nil {
+		im.opts = *opts
+	}
+	if im.opts.KeyPattern == nil {
+		im.opts.KeyPattern = DefaultKeyPattern
+	}
+	if im.opts.KeyProperties == nil {
+		im.opts.KeyProperties = DefaultKeyProperties
+	}
+	if im.opts.Statuses == nil {
+		im.opts.Statuses = DefaultStatuses
+	}
+	return im
+}
+
+// ImportWithContext maps results to test issues and records one execution per issue.
+// Results that map to the same issue are merged, the worst outcome wins.
+// Failures of single executions are reported in the entries of the Report;
+// an error is only returned if the cycle or folder could not be resolved.
+func (im *Importer) ImportWithContext(ctx context.Context, results []Result) (*Report, error) {
+	report := &Report{
+		DryRun:     im.opts.DryRun,
+		CycleName:  im.opts.CycleName,
+		FolderName: im.opts.FolderName,
+	}
+
+	entries, err := im.group(ctx, results)
+	if err != nil {
+		return nil, err
+	}
+	if err := im.resolveStatuses(ctx, entries); err != nil {
+		return nil, err
+	}
+
+	if err := im.resolveCycle(ctx, report); err != nil {
+		return report, err
+	}
+	if err := im.resolveFolder(ctx, report); err != nil {
+		return report, err
+	}
+
+	existing := make(map[int]jira.Execution)
+	if report.CycleID != 0 {
+		opts := &jira.ExecutionListOptions{
+			ProjectID: im.opts.ProjectID,
+			VersionID: im.opts.VersionID,
+			CycleID:   report.CycleID,
+			FolderID:  report.FolderID,
+		}
+		exes, _, err := im.client.Execution.GetAllWithContext(ctx, opts)
+		if err != nil {
+			return report, err
+		}
+		for _, exe := range exes {
+			// Without a folder filter the ZAPI lists the executions of all
+			// folders, only those at the cycle level are ours
+			if report.FolderID == 0 && exe.FolderID != 0 {
+				continue
+			}
+			existing[exe.IssueID] = exe
+		}
+	}
+
+	for _, e := range entries {
+		if e.Action != ActionUnmapped {
+			im.record(ctx, report, &e, existing)
+		}
+		report.Entries = append(report.Entries, e)
+	}
+	return report, nil
+}
+
+// Import wraps ImportWithContext using the background context
+func (im *Importer) Import(results []Result) (*Report, error) {
+	return im.ImportWithContext(context.Background(), results)
+}
+
+// group maps results to issue keys and merges results of the same issue
+func (im *Importer) group(ctx context.Context, results []Result) ([]ReportEntry, error) {
+	var keys []string
+	byKey := make(map[string]*ReportEntry)
+	var unmapped []ReportEntry
+	for _, r := range results {
+		key := issueKey(r, im.opts.KeyProperties, im.opts.KeyPattern)
+		if key == "" && im.opts.MatchByName {
+			var err error
+			key, err = im.keyByName(ctx, r.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if key == "" {
+			unmapped = append(unmapped, ReportEntry{Action: ActionUnmapped, Outcome: r.Outcome, Results: []Result{r}})
+			continue
+		}
+
+		e, ok := byKey[key]
+		if !ok {
+			e = &ReportEntry{IssueKey: key, Outcome: Passed}
+			byKey[key] = e
+			keys = append(keys, key)
+		}
+		e.Outcome = worse(e.Outcome, r.Outcome)
+		e.Results = append(e.Results, r)
+	}
+
+	sort.Strings(keys)
+	entries := make([]ReportEntry, 0, len(keys)+len(unmapped))
+	for _, k := range keys {
+		e := *byKey[k]
+		e.Status = im.opts.Statuses[e.Outcome]
+		entries = append(entries, e)
+	}
+	return append(entries, unmapped...), nil
+}
+
+// keyByName searches the project for an issue whose summary equals name
+func (im *Importer) keyByName(ctx context.Context, name string) (string, error) {
+	if im.opts.ProjectKey == "" || name == "" {
+		return "", nil
+	}
+	escaped := strings.Replace(name, `"`, `\"`, -1)
+	jql := fmt.Sprintf(`project = "%s" AND summary ~ "\"%s\""`, im.opts.ProjectKey, escaped)
+	issues, _, err := im.client.Issue.SearchWithContext(ctx, jql, &jira.SearchOptions{Fields: []string{"summary"}})
+	if err != nil {
+		return "", err
+	}
+	for _, issue := range issues {
+		if issue.Fields != nil && strings.EqualFold(strings.TrimSpace(issue.Fields.Summary), strings.TrimSpace(name)) {
+			if id, err := strconv.Atoi(issue.ID); err == nil {
+				im.issues[issue.Key] = id
+			}
+			return issue.Key, nil
+		}
+	}
+	return "", nil
+}
+
+// resolveStatuses looks up the statuses of the entries and sets their IDs,
+// so that an unknown status fails the import before anything is changed
+func (im *Importer) resolveStatuses(ctx context.Context, entries []ReportEntry) error {
+	catalogue, _, err := im.client.Execution.GetStatusCatalogueWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		if entries[i].Action == ActionUnmapped {
+			continue
+		}
+		status, err := catalogue.ExecutionStatus(entries[i].Status)
+		if err != nil {
+			return err
+		}
+		entries[i].Status = status.Name
+		entries[i].StatusID = status.ID
+	}
+	return nil
+}
+
+func (im *Importer) resolveCycle(ctx context.Context, report *Report) error {
+	cycles, _, err := im.client.Cycle.GetListWithContext(ctx, &jira.CycleListOptions{
+		ProjectID: im.opts.ProjectID,
+		VersionID: im.opts.VersionID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, c := range cycles {
+		if c.Name == im.opts.CycleName {
+			report.CycleID = c.ID
+			return nil
+		}
+	}
+
+	report.CycleCreated = true
+	if im.opts.DryRun {
+		return nil
+	}
+	reply, _, err := im.client.Cycle.CreateWithContext(ctx, &jira.Cycle{
+		Name:      im.opts.CycleName,
+		ProjectID: im.opts.ProjectID,
+		VersionID: im.opts.VersionID,
+	})
+	if err != nil {
+		return err
+	}
+	report.CycleID, err = strconv.Atoi(reply.ID)
+	if err != nil {
+		return fmt.Errorf("%s: unexpected cycle id %q", jira.CycleCreateError, reply.ID)
+	}
+	return nil
+}
+
+func (im *Importer) resolveFolder(ctx context.Context, report *Report) error {
+	if im.opts.FolderName == "" {
+		return nil
+	}
+
+	if report.CycleID != 0 {
+		opts := &jira.FolderListOptions{ProjectID: im.opts.ProjectID, VersionID: im.opts.VersionID}
+		folder, _, err := im.client.Folder.FindWithContext(ctx, report.CycleID, im.opts.FolderName, opts)
+		if err != nil {
+			return err
+		}
+		if folder != nil {
+			report.FolderID = folder.ID
+			return nil
+		}
+	}
+
+	report.FolderCreated = true
+	if im.opts.DryRun {
+		return nil
+	}
+	folder, _, err := im.client.Folder.CreateWithContext(ctx, &jira.Folder{
+		CycleID:   report.CycleID,
+		Name:      im.opts.FolderName,
+		ProjectID: im.opts.ProjectID,
+		VersionID: im.opts.VersionID,
+	})
+	if err != nil {
+		return err
+	}
+	report.FolderID = folder.ID
+	return nil
+}
+
+// record creates the execution of an entry if needed and sets its status
+func (im *Importer) record(ctx context.Context, report *Report, e *ReportEntry, existing map[int]jira.Execution) {
+	issueID, err := im.issueID(ctx, e.IssueKey)
+	if err != nil {
+		e.Action = ActionError
+		e.Err = err
+		return
+	}
+
+	exe, ok := existing[issueID]
+	if ok {
+		e.Action = ActionUpdate
+		e.ExecutionID = exe.ID
+	} else {
+		e.Action = ActionCreate
+	}
+	if im.opts.DryRun {
+		return
+	}
+
+	if !ok {
+		created, _, err := im.client.Execution.CreateWithContext(ctx, &jira.Execution{
+			IssueID:    issueID,
+			ProjectID:  im.opts.ProjectID,
+			VersionID:  im.opts.VersionID,
+			CycleID:    report.CycleID,
+			FolderID:   report.FolderID,
+			AssignedTo: im.opts.Assignee,
+		})
+		if err != nil {
+			e.Err = err
+			return
+		}
+		e.ExecutionID = created.ID
+	}
+
+	status := &jira.ExecutionStatus{
+		Status:   e.StatusID,
+		Assignee: im.opts.Assignee,
+		Comment:  comment(e.Results),
+	}
+	if _, _, err := im.client.Execution.ExecuteWithContext(ctx, e.ExecutionID, status); err != nil {
+		e.Err = err
+	}
+}
+
+// issueID resolves the numeric ID Zephyr needs for an issue key
+func (im *Importer) issueID(ctx context.Context, key string) (int, error) {
+	if id, ok := im.issues[key]; ok {
+		return id, nil
+	}
+	issue, _, err := im.client.Issue.GetWithContext(ctx, key, &jira.GetQueryOptions{Fields: "summary"})
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.Atoi(issue.ID)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected id %q for issue %s", issue.ID, key)
+	}
+	im.issues[key] = id
+	return id, nil
+}
+
+// comment joins the messages of all results that did not pass,
+// cut to the length Zephyr accepts
+func comment(results []Result) string {
+	var lines []string
+	for _, r := range results {
+		if r.Outcome == Passed {
+			continue
+		}
+		line := fmt.Sprintf("[%s] %s", r.Outcome, r.FullName())
+		if r.Message != "" {
+			line += ": " + r.Message
+		}
+		lines = append(lines, line)
+	}
+	c := []rune(strings.Join(lines, "\n"))
+	if len(c) > maxCommentLength {
+		c = append(c[:maxCommentLength-3], []rune("...")...)
+	}
+	return string(c)
+}
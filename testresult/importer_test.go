@@ -0,0 +1,192 @@
+package testresult
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/tya/go-jira"
+)
+
+// zephyrServer fakes the Jira and ZAPI endpoints the importer talks to.
+// The cycle "Nightly" (id 100) exists and holds an execution for SAM-14.
+type zephyrServer struct {
+	t        *testing.T
+	mux      *http.ServeMux
+	created  []jira.Execution
+	executed map[int]jira.ExecutionStatus
+}
+
+func newZephyrServer(t *testing.T) (*zephyrServer, *jira.Client, func()) {
+	zs := &zephyrServer{t: t, mux: http.NewServeMux(), executed: make(map[int]jira.ExecutionStatus)}
+	server := httptest.NewServer(zs.mux)
+
+	zs.mux.HandleFunc("/rest/zapi/latest/util/testExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": -1, "name": "UNEXECUTED"}, {"id": 1, "name": "PASS"}, {"id": 2, "name": "FAIL"}, {"id": 4, "name": "BLOCKED"}]`)
+	})
+	zs.mux.HandleFunc("/rest/zapi/latest/util/teststepExecutionStatus", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": -1, "name": "UNEXECUTED"}, {"id": 1, "name": "PASS"}, {"id": 2, "name": "FAIL"}]`)
+	})
+	zs.mux.HandleFunc("/rest/zapi/latest/cycle", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"id": "200", "responseMessage": "Cycle 200 created successfully."}`)
+			return
+		}
+		fmt.Fprint(w, `{"100": {"name": "Nightly", "projectId": 10000, "versionId": 10001}, "recordsCount": 1}`)
+	})
+	zs.mux.HandleFunc("/rest/zapi/latest/execution", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			exe := jira.Execution{}
+			json.NewDecoder(r.Body).Decode(&exe)
+			exe.ID = 5000 + len(zs.created)
+			zs.created = append(zs.created, exe)
+			json.NewEncoder(w).Encode(map[string]jira.Execution{fmt.Sprint(exe.ID): exe})
+			return
+		}
+		// SAM-15 is only executed in a folder of the cycle
+		fmt.Fprint(w, `{"executions": [
+			{"id": 13377, "issueId": 10013, "issueKey": "SAM-14", "cycleId": 100},
+			{"id": 13378, "issueId": 10014, "issueKey": "SAM-15", "cycleId": 100, "folderId": 7}], "recordsCount": 2}`)
+	})
+	zs.mux.HandleFunc("/rest/zapi/latest/execution/", func(w http.ResponseWriter, r *http.Request) {
+		var id int
+		fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/rest/zapi/latest/execution/"), "%d/execute", &id)
+		status := jira.ExecutionStatus{}
+		json.NewDecoder(r.Body).Decode(&status)
+		zs.executed[id] = status
+		fmt.Fprintf(w, `{"id": %d, "executionStatus": "%d"}`, id, status.Status)
+	})
+	zs.mux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue/")
+		ids := map[string]string{"SAM-14": "10013", "SAM-15": "10014", "SAM-16": "10015"}
+		id, ok := ids[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %q, "key": %q}`, id, key)
+	})
+
+	client, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return zs, client, server.Close
+}
+
+var importResults = []Result{
+	{Name: "SAM-14 login", Outcome: Passed},
+	{Name: "login with bad password", Outcome: Failed, Message: "expected 401", Properties: map[string]string{"jira": "SAM-15"}},
+	{Name: "login with bad password (retry)", Outcome: Passed, Properties: map[string]string{"jira": "SAM-15"}},
+	{Name: "SAM-16 sso", Outcome: Skipped},
+	{Name: "no annotation", Outcome: Passed},
+}
+
+func TestImporter_Import(t *testing.T) {
+	zs, client, teardown := newZephyrServer(t)
+	defer teardown()
+
+	im := NewImporter(client, &ImportOptions{ProjectID: 10000, VersionID: 10001, CycleName: "Nightly"})
+	report, err := im.Import(importResults)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if report.CycleID != 100 || report.CycleCreated {
+		t.Errorf("Expected existing cycle 100, got %d (created %v)", report.CycleID, report.CycleCreated)
+	}
+	if len(report.Entries) != 4 {
+		t.Fatalf("Expected %d entries but got %d", 4, len(report.Entries))
+	}
+	if errs := report.Errors(); len(errs) != 0 {
+		t.Errorf("Unexpected errors: %v", errs[0].Err)
+	}
+
+	wantActions := []Action{ActionUpdate, ActionCreate, ActionCreate, ActionUnmapped}
+	for i, e := range report.Entries {
+		if e.Action != wantActions[i] {
+			t.Errorf("Entry %d: expected action %s but got %s", i, wantActions[i], e.Action)
+		}
+	}
+	if len(zs.created) != 2 {
+		t.Errorf("Expected %d created executions but got %d", 2, len(zs.created))
+	}
+	if _, ok := zs.executed[13378]; ok {
+		t.Error("Expected the execution of SAM-15 in folder 7 to be left alone")
+	}
+	if s := zs.executed[13377]; s.Status != jira.StatusPass {
+		t.Errorf("Expected SAM-14 to pass, got status %s", s.Status)
+	}
+	sam15 := zs.executed[report.Entries[1].ExecutionID]
+	if sam15.Status != jira.StatusFail || !strings.Contains(sam15.Comment, "expected 401") {
+		t.Errorf("Expected SAM-15 to fail with comment, got %+v", sam15)
+	}
+	if s := zs.executed[report.Entries[2].ExecutionID]; s.Status != jira.StatusBlocked {
+		t.Errorf("Expected SAM-16 to be blocked, got status %s", s.Status)
+	}
+}
+
+func TestImporter_Import_DryRun(t *testing.T) {
+	zs, client, teardown := newZephyrServer(t)
+	defer teardown()
+
+	im := NewImporter(client, &ImportOptions{ProjectID: 10000, VersionID: 10001, CycleName: "Release 2", DryRun: true})
+	report, err := im.Import(importResults)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if !report.CycleCreated {
+		t.Error("Expected the cycle to be reported as created")
+	}
+	if len(zs.created) != 0 || len(zs.executed) != 0 {
+		t.Errorf("Dry run changed executions: %d created, %d executed", len(zs.created), len(zs.executed))
+	}
+
+	var out bytes.Buffer
+	report.WriteTo(&out)
+	if !strings.HasPrefix(out.String(), "DRY RUN") {
+		t.Errorf("Expected dry run banner, got %q", out.String())
+	}
+}
+
+func TestImporter_Import_UnknownIssue(t *testing.T) {
+	zs, client, teardown := newZephyrServer(t)
+	defer teardown()
+
+	im := NewImporter(client, &ImportOptions{ProjectID: 10000, VersionID: 10001, CycleName: "Nightly", DryRun: true})
+	report, err := im.Import([]Result{{Name: "SAM-99 removed", Outcome: Passed}})
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if e := report.Entries[0]; e.Action != ActionError || e.Err == nil {
+		t.Errorf("Expected an error entry for SAM-99, got %+v", e)
+	}
+	if len(zs.created) != 0 {
+		t.Errorf("Expected no executions, got %d created", len(zs.created))
+	}
+}
+
+func TestImporter_Import_UnknownStatus(t *testing.T) {
+	zs, client, teardown := newZephyrServer(t)
+	defer teardown()
+
+	statuses := map[Outcome]string{Passed: "PASS", Failed: "FAIL", Errored: "FAIL", Skipped: "SKIPPED"}
+	im := NewImporter(client, &ImportOptions{ProjectID: 10000, VersionID: 10001, CycleName: "Nightly", Statuses: statuses})
+	if _, err := im.Import(importResults); err == nil {
+		t.Error("No error given")
+	}
+	if len(zs.created) != 0 || len(zs.executed) != 0 {
+		t.Errorf("Import with unknown status changed executions: %d created, %d executed", len(zs.created), len(zs.executed))
+	}
+}
+
+func TestComment_Truncates(t *testing.T) {
+	results := []Result{{Name: "x", Outcome: Failed, Message: strings.Repeat("é", 2*maxCommentLength)}}
+	c := []rune(comment(results))
+	if len(c) != maxCommentLength {
+		t.Errorf("Expected comment of %d runes but got %d", maxCommentLength, len(c))
+	}
+}
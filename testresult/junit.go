@@ -0,0 +1,181 @@
+package testresult
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type junitTestSuites struct {
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	Properties []junitProperty  `xml:"properties>property"`
+	Suites     []junitTestSuite `xml:"testsuite"`
+	Cases      []junitTestCase  `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property"`
+	Failures   []junitProblem  `xml:"failure"`
+	Errors     []junitProblem  `xml:"error"`
+	Skipped    *junitProblem   `xml:"skipped"`
+	SystemOut  string          `xml:"system-out"`
+	// surefire reports reruns of failed tests separately
+	RerunFailures []junitProblem `xml:"rerunFailure"`
+	RerunErrors   []junitProblem `xml:"rerunError"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitProblem struct {
+	Message    string `xml:"message,attr"`
+	Type       string `xml:"type,attr"`
+	Body       string `xml:",chardata"`
+	StackTrace string `xml:"stackTrace"`
+}
+
+// ParseJUnit reads a JUnit XML report.
+// Both a single <testsuite> and a <testsuites> root are accepted, as are the
+// surefire/failsafe extensions for reruns and nested suites.
+func ParseJUnit(r io.Reader) ([]Result, error) {
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("junit: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "testsuites":
+			suites := new(junitTestSuites)
+			if err := dec.DecodeElement(suites, &start); err != nil {
+				return nil, fmt.Errorf("junit: %w", err)
+			}
+			var results []Result
+			for _, s := range suites.Suites {
+				results = append(results, s.results(nil)...)
+			}
+			return results, nil
+		case "testsuite":
+			suite := new(junitTestSuite)
+			if err := dec.DecodeElement(suite, &start); err != nil {
+				return nil, fmt.Errorf("junit: %w", err)
+			}
+			return suite.results(nil), nil
+		default:
+			return nil, fmt.Errorf("junit: unexpected root element <%s>", start.Name.Local)
+		}
+	}
+}
+
+// results flattens the suite and its nested suites.
+// Suite level properties are inherited by every test case and may be
+// overridden on the test case itself.
+func (s junitTestSuite) results(inherited map[string]string) []Result {
+	props := make(map[string]string, len(inherited)+len(s.Properties))
+	for k, v := range inherited {
+		props[k] = v
+	}
+	for _, p := range s.Properties {
+		props[p.Name] = p.Value
+	}
+
+	var results []Result
+	for _, c := range s.Cases {
+		results = append(results, c.result(s.Name, props))
+	}
+	for _, nested := range s.Suites {
+		results = append(results, nested.results(props)...)
+	}
+	return results
+}
+
+func (c junitTestCase) result(suite string, inherited map[string]string) Result {
+	r := Result{
+		Suite:      suite,
+		ClassName:  c.ClassName,
+		Name:       c.Name,
+		Outcome:    Passed,
+		Duration:   parseSeconds(c.Time),
+		Properties: make(map[string]string, len(inherited)+len(c.Properties)),
+	}
+	for k, v := range inherited {
+		r.Properties[k] = v
+	}
+	for _, p := range c.Properties {
+		r.Properties[p.Name] = p.Value
+	}
+
+	switch {
+	case len(c.Errors) > 0:
+		r.Outcome = Errored
+		r.Message, r.Details = c.Errors[0].describe()
+	case len(c.Failures) > 0:
+		r.Outcome = Failed
+		r.Message, r.Details = c.Failures[0].describe()
+	case len(c.RerunErrors) > 0:
+		r.Outcome = Errored
+		r.Message, r.Details = c.RerunErrors[len(c.RerunErrors)-1].describe()
+	case len(c.RerunFailures) > 0:
+		r.Outcome = Failed
+		r.Message, r.Details = c.RerunFailures[len(c.RerunFailures)-1].describe()
+	case c.Skipped != nil:
+		r.Outcome = Skipped
+		r.Message, r.Details = c.Skipped.describe()
+	}
+	return r
+}
+
+func (p junitProblem) describe() (message, details string) {
+	message = strings.TrimSpace(p.Message)
+	details = strings.TrimSpace(p.Body)
+	if details == "" {
+		details = strings.TrimSpace(p.StackTrace)
+	}
+	if message == "" {
+		message = p.Type
+	}
+	return message, details
+}
+
+// parseSeconds converts a JUnit time attribute to a duration. The seconds
+// may use a decimal comma ("1,5") or thousands separators ("1,001.5", or
+// "1.001,5") as written by some surefire locales.
+func parseSeconds(s string) time.Duration {
+	s = strings.TrimSpace(s)
+	comma, dot := strings.LastIndex(s, ","), strings.LastIndex(s, ".")
+	switch {
+	case comma < 0:
+	case dot > comma || strings.Count(s, ",") > 1:
+		// commas separate thousands
+		s = strings.Replace(s, ",", "", -1)
+	default:
+		// the last comma is the decimal separator, dots separate thousands
+		s = strings.Replace(s, ".", "", -1)
+		s = strings.Replace(s, ",", ".", 1)
+	}
+	if s == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(f * float64(time.Second))
+}
@@ -0,0 +1,110 @@
+package testresult
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseJUnit_TestSuites(t *testing.T) {
+	f, err := os.Open("./testdata/junit.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	results, err := ParseJUnit(f)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(results) != 4 {
+		t.Fatalf("Expected %d results but got %d", 4, len(results))
+	}
+
+	want := []Outcome{Passed, Failed, Errored, Skipped}
+	for i, r := range results {
+		if r.Outcome != want[i] {
+			t.Errorf("Result %d: expected outcome %s but got %s", i, want[i], r.Outcome)
+		}
+	}
+	if results[1].Message != "expected 401 but got 500" {
+		t.Errorf("Unexpected failure message %q", results[1].Message)
+	}
+	if results[1].Properties["jira"] != "SAM-15" {
+		t.Errorf("Expected testcase property jira=SAM-15 but got %q", results[1].Properties["jira"])
+	}
+	if results[0].Properties["environment"] != "staging" {
+		t.Errorf("Expected suite property to be inherited, got %v", results[0].Properties)
+	}
+	if results[2].Duration != 1001500*time.Millisecond {
+		t.Errorf("Expected duration 1001.5s but got %v", results[2].Duration)
+	}
+}
+
+func TestParseJUnit_Surefire(t *testing.T) {
+	f, err := os.Open("./testdata/surefire.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	results, err := ParseJUnit(f)
+	if err != nil {
+		t.Fatalf("Error given: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected %d results but got %d", 2, len(results))
+	}
+	if results[0].Outcome != Failed || !strings.Contains(results[0].Details, "TimeoutException") {
+		t.Errorf("Expected rerun failure with stack trace, got %+v", results[0])
+	}
+	if results[1].Suite != "com.example.CartTest" {
+		t.Errorf("Expected suite com.example.CartTest but got %s", results[1].Suite)
+	}
+	if !strings.Contains(results[1].Details, "CartTest.java:42") {
+		t.Errorf("Expected CDATA details, got %q", results[1].Details)
+	}
+}
+
+func TestParseJUnit_UnexpectedRoot(t *testing.T) {
+	_, err := ParseJUnit(strings.NewReader(`<?xml version="1.0"?><report/>`))
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestIssueKey(t *testing.T) {
+	r := Result{
+		ClassName:  "auth.SAM-1.LoginTest",
+		Name:       "SAM-2 login",
+		Properties: map[string]string{"jira": "see SAM-3"},
+	}
+	if k := issueKey(r, DefaultKeyProperties, DefaultKeyPattern); k != "SAM-3" {
+		t.Errorf("Expected property key SAM-3 but got %s", k)
+	}
+	r.Properties = nil
+	if k := issueKey(r, DefaultKeyProperties, DefaultKeyPattern); k != "SAM-2" {
+		t.Errorf("Expected name key SAM-2 but got %s", k)
+	}
+	r.Name = "login"
+	if k := issueKey(r, DefaultKeyProperties, DefaultKeyPattern); k != "SAM-1" {
+		t.Errorf("Expected class name key SAM-1 but got %s", k)
+	}
+}
+
+func TestParseSeconds(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"":          0,
+		"0.25":      250 * time.Millisecond,
+		"1,5":       1500 * time.Millisecond,
+		"1,001.5":   1001500 * time.Millisecond,
+		"1.001,5":   1001500 * time.Millisecond,
+		"1,234,567": 1234567 * time.Second,
+		"n/a":       0,
+	} {
+		if got := parseSeconds(in); got != want {
+			t.Errorf("parseSeconds(%q): expected %v but got %v", in, want, got)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Package testresult parses the result files produced by test runners
+// (JUnit XML, Cucumber JSON and `go test -json`) into a common model and
+// records them as Zephyr executions through a jira.Client.
+package testresult
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Outcome is the result of a single test case
+type Outcome string
+
+// Outcomes a test case can end with
+const (
+	Passed  Outcome = "passed"
+	Failed  Outcome = "failed"
+	Errored Outcome = "errored"
+	Skipped Outcome = "skipped"
+)
+
+// severity orders outcomes so the worst one wins when several results are
+// recorded against the same test issue
+var severity = map[Outcome]int{
+	Passed:  0,
+	Skipped: 1,
+	Failed:  2,
+	Errored: 3,
+}
+
+// worse returns the more severe of two outcomes
+func worse(a, b Outcome) Outcome {
+	if severity[b] > severity[a] {
+		return b
+	}
+	return a
+}
+
+// Result is a single test case as reported by a test runner
+type Result struct {
+	// Suite is the name of the suite (or package) the test belongs to
+	Suite string
+	// ClassName is the fully qualified class name, if the format has one
+	ClassName string
+	Name      string
+	Outcome   Outcome
+	// Message is the short failure, error or skip message
+	Message string
+	// Details holds the stack trace or captured output of a failure
+	Details  string
+	Duration time.Duration
+	// Properties are key/value annotations attached to the test case
+	Properties map[string]string
+	// Tags are the labels of a test case, e.g. Cucumber tags without the leading "@"
+	Tags []string
+}
+
+// FullName returns the name of the test qualified by its class or suite
+func (r Result) FullName() string {
+	if r.ClassName != "" {
+		return r.ClassName + "." + r.Name
+	}
+	if r.Suite != "" {
+		return r.Suite + "." + r.Name
+	}
+	return r.Name
+}
+
+// DefaultKeyPattern matches Jira issue keys like "PROJ-123".
+// The key is captured by the first group, so that keys within Go subtest
+// names like "TestLogin/PROJ-123_valid" are found as well.
+var DefaultKeyPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9])([A-Z][A-Z0-9_]+-[0-9]+)(?:[^0-9]|$)`)
+
+// DefaultKeyProperties are the test case properties consulted for an issue key
+var DefaultKeyProperties = []string{"jira", "issue", "test_key", "testKey"}
+
+// issueKey returns the Jira issue key a result is annotated with.
+// Properties take precedence over tags, tags over keys embedded in the test
+// or class name.
+func issueKey(r Result, properties []string, pattern *regexp.Regexp) string {
+	for _, p := range properties {
+		if v, ok := r.Properties[p]; ok {
+			if k := findKey(pattern, v); k != "" {
+				return k
+			}
+		}
+	}
+	for _, tag := range r.Tags {
+		if k := findKey(pattern, tag); k != "" {
+			return k
+		}
+	}
+	if k := findKey(pattern, r.Name); k != "" {
+		return k
+	}
+	return findKey(pattern, r.ClassName)
+}
+
+// findKey returns the first submatch of pattern, or the whole match if the
+// pattern has no groups
+func findKey(pattern *regexp.Regexp, s string) string {
+	m := pattern.FindStringSubmatch(s)
+	switch {
+	case m == nil:
+		return ""
+	case len(m) > 1:
+		return m[1]
+	default:
+		return m[0]
+	}
+}
+
+// Formats accepted by Parse
+const (
+	FormatJUnit    = "junit"
+	FormatCucumber = "cucumber"
+	FormatGoTest   = "gotest"
+)
+
+// Parse reads results in the given format
+func Parse(format string, r io.Reader) ([]Result, error) {
+	switch strings.ToLower(format) {
+	case FormatJUnit, "surefire", "xml":
+		return ParseJUnit(r)
+	case FormatCucumber:
+		return ParseCucumber(r)
+	case FormatGoTest, "test2json":
+		return ParseGoTest(r)
+	}
+	return nil, fmt.Errorf("testresult: unknown format %q", format)
+}
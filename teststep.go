@@ -0,0 +1,201 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	TestStepListError   = "Test Step List Error"
+	TestStepGetError    = "Test Step Get Error"
+	TestStepCreateError = "Test Step Create Error"
+	TestStepUpdateError = "Test Step Update Error"
+	TestStepDeleteError = "Test Step Delete Error"
+	TestStepMoveError   = "Test Step Move Error"
+)
+
+var (
+	testStepEndpointFormat     = "/rest/zapi/latest/teststep/%d"
+	testStepItemEndpointFormat = "/rest/zapi/latest/teststep/%d/%d"
+	testStepMoveEndpointFormat = "/rest/zapi/latest/teststep/%d/%d/move"
+)
+
+// TestStepService handles the steps of a Zephyr test issue.
+//
+// Zephyr API docs: https://getzephyr.docs.apiary.io/#reference/teststepresource
+type TestStepService struct {
+	client *Client
+}
+
+// TestStep represents a single step of a Zephyr test issue
+type TestStep struct {
+	ID         int    `json:"id,omitempty"`
+	OrderID    int    `json:"orderId,omitempty"`
+	Step       string `json:"step,omitempty"`
+	Data       string `json:"data,omitempty"`
+	Result     string `json:"result,omitempty"`
+	HTMLStep   string `json:"htmlStep,omitempty"`
+	HTMLData   string `json:"htmlData,omitempty"`
+	HTMLResult string `json:"htmlResult,omitempty"`
+}
+
+// testStepList is the paged wrapper newer ZAPI versions use for the step list
+type testStepList struct {
+	StepBeanCollection []TestStep `json:"stepBeanCollection"`
+}
+
+// TestStepMoveOptions describes where a step is moved to.
+// Either Position ("First" or "Last") or After is set. After is the URL path
+// of the step the moved step follows, e.g. "/rest/zapi/latest/teststep/10100/3",
+// not its bare ID; MoveAfter builds it from the IDs.
+type TestStepMoveOptions struct {
+	Position string `json:"position,omitempty"`
+	After    string `json:"after,omitempty"`
+}
+
+// GetListWithContext gets the steps of a test issue
+func (s *TestStepService) GetListWithContext(ctx context.Context, issueID int) ([]TestStep, *Response, error) {
+	endpoint := fmt.Sprintf(testStepEndpointFormat, issueID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", TestStepListError, err)
+	}
+
+	var raw json.RawMessage
+	resp, err := s.client.Do(req, &raw)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", TestStepListError, err)
+	}
+
+	// older ZAPI versions reply with a plain array, newer ones wrap it
+	var steps []TestStep
+	if err = json.Unmarshal(raw, &steps); err == nil {
+		return steps, resp, nil
+	}
+	list := new(testStepList)
+	if err = json.Unmarshal(raw, list); err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", TestStepListError, err)
+	}
+	return list.StepBeanCollection, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context
+func (s *TestStepService) GetList(issueID int) ([]TestStep, *Response, error) {
+	return s.GetListWithContext(context.Background(), issueID)
+}
+
+// GetWithContext gets a single step of a test issue
+func (s *TestStepService) GetWithContext(ctx context.Context, issueID, stepID int) (*TestStep, *Response, error) {
+	endpoint := fmt.Sprintf(testStepItemEndpointFormat, issueID, stepID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", TestStepGetError, err)
+	}
+
+	step := new(TestStep)
+	resp, err := s.client.Do(req, step)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", TestStepGetError, err)
+	}
+	return step, resp, nil
+}
+
+// Get wraps GetWithContext using the background context
+func (s *TestStepService) Get(issueID, stepID int) (*TestStep, *Response, error) {
+	return s.GetWithContext(context.Background(), issueID, stepID)
+}
+
+// CreateWithContext appends a step to a test issue
+func (s *TestStepService) CreateWithContext(ctx context.Context, issueID int, step *TestStep) (*TestStep, *Response, error) {
+	endpoint := fmt.Sprintf(testStepEndpointFormat, issueID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, endpoint, step)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", TestStepCreateError, err)
+	}
+
+	reply := new(TestStep)
+	resp, err := s.client.Do(req, reply)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", TestStepCreateError, err)
+	}
+	return reply, resp, nil
+}
+
+// Create wraps CreateWithContext using the background context
+func (s *TestStepService) Create(issueID int, step *TestStep) (*TestStep, *Response, error) {
+	return s.CreateWithContext(context.Background(), issueID, step)
+}
+
+// UpdateWithContext updates the step identified by step.ID
+func (s *TestStepService) UpdateWithContext(ctx context.Context, issueID int, step *TestStep) (*TestStep, *Response, error) {
+	endpoint := fmt.Sprintf(testStepItemEndpointFormat, issueID, step.ID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPut, endpoint, step)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", TestStepUpdateError, err)
+	}
+
+	reply := new(TestStep)
+	resp, err := s.client.Do(req, reply)
+	if err != nil {
+		return nil, resp, fmt.Errorf("%s: %w", TestStepUpdateError, err)
+	}
+	return reply, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context
+func (s *TestStepService) Update(issueID int, step *TestStep) (*TestStep, *Response, error) {
+	return s.UpdateWithContext(context.Background(), issueID, step)
+}
+
+// DeleteWithContext removes a step from a test issue
+func (s *TestStepService) DeleteWithContext(ctx context.Context, issueID, stepID int) (*Response, error) {
+	endpoint := fmt.Sprintf(testStepItemEndpointFormat, issueID, stepID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", TestStepDeleteError, err)
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", TestStepDeleteError, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context
+func (s *TestStepService) Delete(issueID, stepID int) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), issueID, stepID)
+}
+
+// MoveWithContext reorders a step within a test issue
+func (s *TestStepService) MoveWithContext(ctx context.Context, issueID, stepID int, opts *TestStepMoveOptions) (*Response, error) {
+	endpoint := fmt.Sprintf(testStepMoveEndpointFormat, issueID, stepID)
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodPost, endpoint, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", TestStepMoveError, err)
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", TestStepMoveError, err)
+	}
+	return resp, nil
+}
+
+// Move wraps MoveWithContext using the background context
+func (s *TestStepService) Move(issueID, stepID int, opts *TestStepMoveOptions) (*Response, error) {
+	return s.MoveWithContext(context.Background(), issueID, stepID, opts)
+}
+
+// MoveAfterWithContext moves a step directly behind the step afterID
+func (s *TestStepService) MoveAfterWithContext(ctx context.Context, issueID, stepID, afterID int) (*Response, error) {
+	after := fmt.Sprintf(testStepItemEndpointFormat, issueID, afterID)
+	return s.MoveWithContext(ctx, issueID, stepID, &TestStepMoveOptions{After: after})
+}
+
+// MoveAfter wraps MoveAfterWithContext using the background context
+func (s *TestStepService) MoveAfter(issueID, stepID, afterID int) (*Response, error) {
+	return s.MoveAfterWithContext(context.Background(), issueID, stepID, afterID)
+}
@@ -0,0 +1,210 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestTestStepService_GetList_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueID := 10013
+	endpoint := fmt.Sprintf(testStepEndpointFormat, issueID)
+
+	raw, err := ioutil.ReadFile("./mocks/teststeps.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, endpoint)
+		fmt.Fprint(w, string(raw))
+	})
+
+	steps, _, err := testClient.TestStep.GetList(issueID)
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if len(steps) != 2 {
+		t.Errorf("Expected %d steps but got %d", 2, len(steps))
+	}
+}
+
+func TestTestStepService_GetList_Paged(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueID := 10013
+	endpoint := fmt.Sprintf(testStepEndpointFormat, issueID)
+
+	raw, err := ioutil.ReadFile("./mocks/teststeps_paged.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, endpoint)
+		fmt.Fprint(w, string(raw))
+	})
+
+	steps, _, err := testClient.TestStep.GetList(issueID)
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if len(steps) != 1 {
+		t.Errorf("Expected %d steps but got %d", 1, len(steps))
+	}
+}
+
+func TestTestStepService_GetList_RequestError(t *testing.T) {
+	setup()
+	backup := testStepEndpointFormat
+	defer func() {
+		testStepEndpointFormat = backup
+		teardown()
+	}()
+
+	// set an invalid endpoint to trigger a request error
+	testStepEndpointFormat = "%d\r"
+
+	steps, _, err := testClient.TestStep.GetList(1)
+	if steps != nil {
+		t.Errorf("Actual step list has %d entries but expected nil", len(steps))
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestTestStepService_GetList_HttpError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueID := 10013
+	endpoint := fmt.Sprintf(testStepEndpointFormat, issueID)
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	steps, _, err := testClient.TestStep.GetList(issueID)
+	if steps != nil {
+		t.Errorf("Actual step list has %d entries but expected nil", len(steps))
+	}
+	if err == nil {
+		t.Errorf("No error given")
+	}
+}
+
+func TestTestStepService_Create_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueID := 10013
+	endpoint := fmt.Sprintf(testStepEndpointFormat, issueID)
+
+	raw, err := ioutil.ReadFile("./mocks/teststep.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, endpoint)
+
+		step := new(TestStep)
+		if err := json.NewDecoder(r.Body).Decode(step); err != nil {
+			t.Error(err.Error())
+		}
+		if step.Step != "Log out" {
+			t.Errorf("Server expected step %q but got %q", "Log out", step.Step)
+		}
+		fmt.Fprint(w, string(raw))
+	})
+
+	step, _, err := testClient.TestStep.Create(issueID, &TestStep{Step: "Log out", Result: "Login page is shown"})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if step == nil {
+		t.Error("Expected step. Reply is nil")
+		return
+	}
+	if step.ID != 3 {
+		t.Errorf("Expected id 3 but got %d", step.ID)
+	}
+}
+
+func TestTestStepService_Update_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueID := 10013
+	endpoint := fmt.Sprintf(testStepItemEndpointFormat, issueID, 3)
+
+	raw, err := ioutil.ReadFile("./mocks/teststep.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, endpoint)
+		fmt.Fprint(w, string(raw))
+	})
+
+	step, _, err := testClient.TestStep.Update(issueID, &TestStep{ID: 3, Step: "Log out"})
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+	if step == nil || step.ID != 3 {
+		t.Errorf("Expected step with id 3 but got %v", step)
+	}
+}
+
+func TestTestStepService_Delete_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueID := 10013
+	endpoint := fmt.Sprintf(testStepItemEndpointFormat, issueID, 3)
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, endpoint)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := testClient.TestStep.Delete(issueID, 3)
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+}
+
+func TestTestStepService_MoveAfter_Success(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueID := 10013
+	endpoint := fmt.Sprintf(testStepMoveEndpointFormat, issueID, 3)
+	testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, endpoint)
+
+		opts := new(TestStepMoveOptions)
+		if err := json.NewDecoder(r.Body).Decode(opts); err != nil {
+			t.Error(err.Error())
+		}
+		want := fmt.Sprintf(testStepItemEndpointFormat, issueID, 1)
+		if opts.After != want {
+			t.Errorf("Server expected after %q but got %q", want, opts.After)
+		}
+		fmt.Fprint(w, "[]")
+	})
+
+	_, err := testClient.TestStep.MoveAfter(issueID, 3, 1)
+	if err != nil {
+		t.Errorf("Error given: %v", err)
+	}
+}
@@ -0,0 +1,350 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// TransitionOption adds a field value, an update operation or a comment to
+// the payload of IssueService.TransitionTo
+type TransitionOption func(*transitionRequest)
+
+// transitionRequest is the payload of IssueService.TransitionTo
+type transitionRequest struct {
+	Transition TransitionPayload                   `json:"transition"`
+	Fields     map[string]interface{}              `json:"fields,omitempty"`
+	Update     map[string][]map[string]interface{} `json:"update,omitempty"`
+}
+
+// TransitionWithField sets the field identified by fieldID, e.g. "resolution"
+// or "customfield_10010", to value. A string (or []string) value is expanded:
+// it is matched against the allowed values of the field, and sent as the name
+// or value a field of its type expects, e.g. {"name": "Done"} for a resolution.
+// Other values are sent as they are.
+func TransitionWithField(fieldID string, value interface{}) TransitionOption {
+	return func(r *transitionRequest) {
+		if r.Fields == nil {
+			r.Fields = make(map[string]interface{})
+		}
+		r.Fields[fieldID] = value
+	}
+}
+
+// TransitionWithUpdate adds the update operation, e.g. "add" or "remove", of
+// the field identified by fieldID. Values are expanded as by TransitionWithField.
+func TransitionWithUpdate(fieldID, operation string, value interface{}) TransitionOption {
+	return func(r *transitionRequest) {
+		if r.Update == nil {
+			r.Update = make(map[string][]map[string]interface{})
+		}
+		r.Update[fieldID] = append(r.Update[fieldID], map[string]interface{}{operation: value})
+	}
+}
+
+// TransitionWithComment adds a comment to the issue with the transition
+func TransitionWithComment(body string) TransitionOption {
+	return TransitionWithUpdate("comment", "add", map[string]interface{}{"body": body})
+}
+
+// TransitionFieldsError is returned by IssueService.TransitionTo when fields
+// required by the transition are neither given nor set on the issue
+type TransitionFieldsError struct {
+	IssueID    string
+	Transition Transition
+	// Missing are the IDs of the required fields, sorted
+	Missing []string
+}
+
+func (e *TransitionFieldsError) Error() string {
+	var fields []string
+	for _, id := range e.Missing {
+		f := e.Transition.Fields[id]
+		desc := fmt.Sprintf("%s (%s)", f.Name, id)
+		if len(f.AllowedValues) > 0 {
+			desc += " of " + describeAllowedValues(f.AllowedValues)
+		}
+		fields = append(fields, desc)
+	}
+	return fmt.Sprintf("jira: transition %q of %s requires the fields %s", e.Transition.Name, e.IssueID, strings.Join(fields, ", "))
+}
+
+// TransitionToWithContext moves an issue with the transition called name or,
+// if there is none, the transition to the status called name, both matched
+// case insensitively. Field values, update operations and a comment given as
+// options are validated against the screen of the transition and sent in a
+// single request. Required fields without a default that are neither given
+// nor already set on the issue are reported as a *TransitionFieldsError,
+// before the transition is attempted.
+//
+// Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-doTransition
+func (s *IssueService) TransitionToWithContext(ctx context.Context, issueID, name string, options ...TransitionOption) (*Transition, *Response, error) {
+	transitions, resp, err := s.GetTransitionsWithContext(ctx, issueID)
+	if err != nil {
+		return nil, resp, err
+	}
+	t, err := findTransition(issueID, transitions, name)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	payload, resp, err := s.transitionRequestWithContext(ctx, issueID, t, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp, err = s.DoTransitionWithPayloadWithContext(ctx, issueID, payload)
+	if err != nil {
+		return nil, resp, err
+	}
+	return t, resp, nil
+}
+
+// TransitionTo wraps TransitionToWithContext using the background context.
+func (s *IssueService) TransitionTo(issueID, name string, options ...TransitionOption) (*Transition, *Response, error) {
+	return s.TransitionToWithContext(context.Background(), issueID, name, options...)
+}
+
+// findTransition returns the transition called name or else the only one to the status called name
+func findTransition(issueID string, transitions []Transition, name string) (*Transition, error) {
+	var to []*Transition
+	for i := range transitions {
+		t := &transitions[i]
+		if strings.EqualFold(t.Name, name) {
+			return t, nil
+		}
+		if strings.EqualFold(t.To.Name, name) {
+			to = append(to, t)
+		}
+	}
+	if len(to) == 1 {
+		return to[0], nil
+	}
+
+	var names []string
+	for _, t := range transitions {
+		names = append(names, fmt.Sprintf("%q to %q", t.Name, t.To.Name))
+	}
+	if len(to) > 1 {
+		return nil, fmt.Errorf("jira: several transitions of %s lead to %q: %s", issueID, name, strings.Join(names, ", "))
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("jira: %s has no transitions", issueID)
+	}
+	return nil, fmt.Errorf("jira: %s has no transition %q, the transitions are %s", issueID, name, strings.Join(names, ", "))
+}
+
+// transitionRequestWithContext returns the payload of transition t of the issue with options,
+// validated against its screen. Required fields not in options are looked up on the issue.
+func (s *IssueService) transitionRequestWithContext(ctx context.Context, issueID string, t *Transition, options []TransitionOption) (*transitionRequest, *Response, error) {
+	payload := &transitionRequest{Transition: TransitionPayload{ID: t.ID}}
+	for _, option := range options {
+		option(payload)
+	}
+	err := payload.validate(issueID, t)
+	fieldsErr, ok := err.(*TransitionFieldsError)
+	if !ok {
+		if err != nil {
+			return nil, nil, err
+		}
+		return payload, nil, nil
+	}
+
+	values, resp, err := s.fieldValuesWithContext(ctx, issueID, fieldsErr.Missing)
+	if err != nil {
+		return nil, resp, err
+	}
+	var missing []string
+	for _, id := range fieldsErr.Missing {
+		if isEmptyFieldValue(values[id]) {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		fieldsErr.Missing = missing
+		return nil, resp, fieldsErr
+	}
+	return payload, resp, nil
+}
+
+// fieldValuesWithContext returns the values of the fields of the issue, by ID
+func (s *IssueService) fieldValuesWithContext(ctx context.Context, issueID string, fields []string) (map[string]interface{}, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s?fields=%s", issueID, url.QueryEscape(strings.Join(fields, ",")))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	issue := new(struct {
+		Fields map[string]interface{} `json:"fields"`
+	})
+	resp, err := s.client.Do(req, issue)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return issue.Fields, resp, nil
+}
+
+// isEmptyFieldValue tells whether a field value decoded from JSON is unset
+func isEmptyFieldValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// validate checks the fields and updates of r against the screen of t and expands their values.
+// Required fields without a default missing from r are reported last, as a *TransitionFieldsError.
+func (r *transitionRequest) validate(issueID string, t *Transition) error {
+	field := func(id string) (TransitionField, error) {
+		f, ok := t.Fields[id]
+		if !ok && t.Fields != nil && id != "comment" {
+			return f, fmt.Errorf("jira: the field %q is not on the screen of transition %q of %s", id, t.Name, issueID)
+		}
+		return f, nil
+	}
+
+	for id, value := range r.Fields {
+		f, err := field(id)
+		if err != nil {
+			return err
+		}
+		if r.Fields[id], err = expandTransitionValue(id, &f, f.Schema.Type, value); err != nil {
+			return err
+		}
+	}
+	for id, operations := range r.Update {
+		f, err := field(id)
+		if err != nil {
+			return err
+		}
+		for _, op := range operations {
+			for name, value := range op {
+				if len(f.Operations) > 0 && !containsString(f.Operations, name) {
+					return fmt.Errorf("jira: the field %q does not support the operation %q, only %s", id, name, strings.Join(f.Operations, ", "))
+				}
+				// Operations act on the items of array fields
+				typ := f.Schema.Type
+				if typ == "array" {
+					typ = f.Schema.Items
+				}
+				if op[name], err = expandTransitionValue(id, &f, typ, value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	var missing []string
+	for id, f := range t.Fields {
+		_, set := r.Fields[id]
+		_, updated := r.Update[id]
+		if f.Required && !f.HasDefaultValue && !set && !updated {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return &TransitionFieldsError{IssueID: issueID, Transition: *t, Missing: missing}
+	}
+	return nil
+}
+
+// expandTransitionValue expands a string or []string value of field id of type typ
+func expandTransitionValue(id string, f *TransitionField, typ string, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if typ == "array" {
+			return expandTransitionValue(id, f, typ, []string{v})
+		}
+		return expandTransitionString(id, f, typ, v)
+	case []string:
+		itemType := typ
+		if typ == "array" {
+			itemType = f.Schema.Items
+		}
+		values := make([]interface{}, len(v))
+		for i, s := range v {
+			e, err := expandTransitionString(id, f, itemType, s)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = e
+		}
+		return values, nil
+	}
+	return value, nil
+}
+
+// expandTransitionString returns the allowed value of field id matching s, by ID, name or value,
+// or s in the representation of its type typ if the field has no allowed values
+func expandTransitionString(id string, f *TransitionField, typ, s string) (interface{}, error) {
+	if len(f.AllowedValues) == 0 {
+		return namedFieldValue(typ, s), nil
+	}
+	for _, allowed := range f.AllowedValues {
+		switch a := allowed.(type) {
+		case string:
+			if strings.EqualFold(a, s) {
+				return namedFieldValue(typ, a), nil
+			}
+		case map[string]interface{}:
+			for _, key := range []string{"id", "name", "value", "key"} {
+				if v, ok := a[key].(string); ok && strings.EqualFold(v, s) {
+					if allowedID, ok := a["id"].(string); ok {
+						return map[string]interface{}{"id": allowedID}, nil
+					}
+					return map[string]interface{}{key: v}, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("jira: %q is not an allowed value of the field %s (%s), the allowed values are %s", s, f.Name, id, describeAllowedValues(f.AllowedValues))
+}
+
+// namedFieldValue returns s as a value of a field of type typ, e.g. {"name": s} for a priority
+func namedFieldValue(typ, s string) interface{} {
+	switch typ {
+	case "option":
+		return map[string]interface{}{"value": s}
+	case "user", "group", "priority", "resolution", "version", "component", "issuetype", "status", "project":
+		return map[string]interface{}{"name": s}
+	}
+	return s
+}
+
+// describeAllowedValues lists the names or values of allowed values for errors
+func describeAllowedValues(values []interface{}) string {
+	var names []string
+	for _, allowed := range values {
+		switch a := allowed.(type) {
+		case string:
+			names = append(names, fmt.Sprintf("%q", a))
+		case map[string]interface{}:
+			for _, key := range []string{"name", "value", "key", "id"} {
+				if v, ok := a[key].(string); ok {
+					names = append(names, fmt.Sprintf("%q", v))
+					break
+				}
+			}
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testTransitionsJSON = `{"transitions": [
+	{"id": "11", "name": "Start Progress", "to": {"id": "3", "name": "In Progress"}, "fields": {}},
+	{"id": "31", "name": "Resolve", "to": {"id": "5", "name": "Done"}, "fields": {
+		"resolution": {"required": true, "name": "Resolution", "schema": {"type": "resolution"}, "hasDefaultValue": false,
+			"operations": ["set"], "allowedValues": [{"id": "1", "name": "Fixed"}, {"id": "2", "name": "Won't Fix"}]},
+		"fixVersions": {"required": false, "name": "Fix Version/s", "schema": {"type": "array", "items": "version"}, "hasDefaultValue": false,
+			"operations": ["set", "add", "remove"]},
+		"customfield_10010": {"required": true, "name": "Root cause", "schema": {"type": "option"}, "hasDefaultValue": true}
+	}}]}`
+
+func TestIssueService_TransitionTo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/api/2/issue/PAY-1/transitions"
+	var payload map[string]interface{}
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Errorf("Got error: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, testTransitionsJSON)
+	})
+
+	transition, _, err := testClient.Issue.TransitionTo("PAY-1", "done",
+		TransitionWithField("resolution", "won't fix"),
+		TransitionWithUpdate("fixVersions", "add", "1.2"),
+		TransitionWithComment("Duplicate of PAY-2"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if transition.ID != "31" {
+		t.Errorf("Expected the transition 31, got %s", transition.ID)
+	}
+
+	want := map[string]interface{}{
+		"transition": map[string]interface{}{"id": "31"},
+		"fields":     map[string]interface{}{"resolution": map[string]interface{}{"id": "2"}},
+		"update": map[string]interface{}{
+			"fixVersions": []interface{}{map[string]interface{}{"add": map[string]interface{}{"name": "1.2"}}},
+			"comment":     []interface{}{map[string]interface{}{"add": map[string]interface{}{"body": "Duplicate of PAY-2"}}},
+		},
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("Unexpected payload %v, want %v", payload, want)
+	}
+}
+
+func TestIssueService_TransitionTo_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/PAY-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			t.Error("Expected no transition to be made")
+		}
+		fmt.Fprint(w, testTransitionsJSON)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/PAY-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"fields": "resolution"})
+		fmt.Fprint(w, `{"key": "PAY-1", "fields": {"resolution": null}}`)
+	})
+
+	_, _, err := testClient.Issue.TransitionTo("PAY-1", "Resolve")
+	fieldsErr, ok := err.(*TransitionFieldsError)
+	if !ok {
+		t.Fatalf("Expected a *TransitionFieldsError, got %v", err)
+	}
+	if !reflect.DeepEqual(fieldsErr.Missing, []string{"resolution"}) {
+		t.Errorf("Expected the resolution to be missing, got %v", fieldsErr.Missing)
+	}
+	if want := `jira: transition "Resolve" of PAY-1 requires the fields Resolution (resolution) of "Fixed", "Won't Fix"`; err.Error() != want {
+		t.Errorf("Unexpected error %q, want %q", err, want)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		options []TransitionOption
+		want    string
+	}{
+		{"Closed", nil, `jira: PAY-1 has no transition "Closed", the transitions are "Start Progress" to "In Progress", "Resolve" to "Done"`},
+		{"Done", []TransitionOption{TransitionWithField("resolution", "Duplicate")},
+			`jira: "Duplicate" is not an allowed value of the field Resolution (resolution), the allowed values are "Fixed", "Won't Fix"`},
+		{"Done", []TransitionOption{TransitionWithField("resolution", "Fixed"), TransitionWithField("assignee", "me")},
+			`jira: the field "assignee" is not on the screen of transition "Resolve" of PAY-1`},
+		{"Done", []TransitionOption{TransitionWithField("resolution", "Fixed"), TransitionWithUpdate("resolution", "add", "Fixed")},
+			`jira: the field "resolution" does not support the operation "add", only set`},
+	} {
+		if _, _, err := testClient.Issue.TransitionTo("PAY-1", tc.name, tc.options...); err == nil || err.Error() != tc.want {
+			t.Errorf("Expected the error %q, got %v", tc.want, err)
+		}
+	}
+}
+
+func TestIssueService_TransitionTo_fieldSet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	transitioned := false
+	testMux.HandleFunc("/rest/api/2/issue/PAY-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			transitioned = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"transitions": [{"id": "31", "name": "Release", "to": {"id": "5", "name": "Done"}, "fields": {
+			"fixVersions": {"required": true, "name": "Fix Version/s", "schema": {"type": "array", "items": "version"}}}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/PAY-1", func(w http.ResponseWriter, r *http.Request) {
+		testRequestParams(t, r, map[string]string{"fields": "fixVersions"})
+		fmt.Fprint(w, `{"key": "PAY-1", "fields": {"fixVersions": [{"id": "10100", "name": "1.2"}]}}`)
+	})
+
+	if _, _, err := testClient.Issue.TransitionTo("PAY-1", "Release"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !transitioned {
+		t.Error("Expected the transition to be made, as the issue has a fix version")
+	}
+}
@@ -0,0 +1,340 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TransitionPathOptions specifies the optional parameters of IssueService.MoveToStatus
+type TransitionPathOptions struct {
+	// Category makes the target a status category, matched by key or name,
+	// e.g. StatusCategoryComplete, instead of a status
+	Category bool
+	// DryRun finds the path and validates the fields of its steps without
+	// transitioning the issue. The screens of the steps after the first are
+	// read from other issues of the project and type of the issue; steps
+	// whose screen cannot be read are returned with nil Fields, unchecked.
+	DryRun bool
+	// StepOptions returns the field values, updates and comment sent with a
+	// step of the path. It may be nil.
+	StepOptions func(step Transition) []TransitionOption
+}
+
+// TransitionPathError is returned by IssueService.MoveToStatus when a step of
+// the path cannot be made. Err is a *TransitionFieldsError when fields
+// required by the step have no value.
+type TransitionPathError struct {
+	IssueID string
+	Path    []Transition
+	// Step is the index in Path of the failed step, the number of steps made
+	Step int
+	Err  error
+}
+
+func (e *TransitionPathError) Error() string {
+	t := e.Path[e.Step]
+	return fmt.Sprintf("jira: step %d of %d moving %s, %q to %q, failed: %v", e.Step+1, len(e.Path), e.IssueID, t.Name, t.To.Name, e.Err)
+}
+
+// Unwrap returns the error of the failed step
+func (e *TransitionPathError) Unwrap() error {
+	return e.Err
+}
+
+// MoveToStatusWithContext moves an issue to the status called target, or with
+// the ID target, along the shortest path of transitions, and returns the path.
+// An issue already in the target status is not moved.
+//
+// The path is found on the workflow of the issue when its definition may be
+// read, which needs administrator permissions. Otherwise the transitions out
+// of every status are learnt from GetTransitions of an issue of the same
+// project and type in that status; if no path is found, the error names the
+// statuses no such issue was found in. Each step is checked against the
+// transitions then available to the issue before it is made.
+//
+// The steps are separate transitions, so a run failing at a step leaves the
+// issue in the status the steps before moved it to. TransitionPathError.Step
+// is the number of steps made then. Use DryRun to check the path first.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-workflow-search-get
+func (s *IssueService) MoveToStatusWithContext(ctx context.Context, issueID, target string, options *TransitionPathOptions) ([]Transition, *Response, error) {
+	if options == nil {
+		options = &TransitionPathOptions{}
+	}
+	issue, resp, err := s.GetWithContext(ctx, issueID, &GetQueryOptions{Fields: "status,issuetype,project"})
+	if err != nil {
+		return nil, resp, err
+	}
+	path, pathResp, err := s.findTransitionPathWithContext(ctx, issueID, issue, target, options.Category)
+	if pathResp != nil {
+		resp = pathResp
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	stepOptions := func(step Transition) []TransitionOption {
+		if options.StepOptions == nil {
+			return nil
+		}
+		return options.StepOptions(step)
+	}
+
+	if options.DryRun {
+		for i := range path {
+			if path[i].Fields == nil && i > 0 {
+				// The workflow definition has no screens, read the step on another issue
+				transitions, _, stepResp, err := s.sampleTransitionsWithContext(ctx, issue, path[i-1].To.ID)
+				if err != nil {
+					return path, stepResp, &TransitionPathError{IssueID: issueID, Path: path, Step: i, Err: err}
+				}
+				for _, t := range transitions {
+					if t.ID == path[i].ID {
+						path[i].Fields = t.Fields
+					}
+				}
+			}
+			if path[i].Fields == nil {
+				continue
+			}
+			if _, stepResp, err := s.transitionRequestWithContext(ctx, issueID, &path[i], stepOptions(path[i])); err != nil {
+				return path, stepResp, &TransitionPathError{IssueID: issueID, Path: path, Step: i, Err: err}
+			}
+		}
+		return path, resp, nil
+	}
+
+	for i, step := range path {
+		var transitions []Transition
+		transitions, resp, err = s.GetTransitionsWithContext(ctx, issueID)
+		if err != nil {
+			return path, resp, &TransitionPathError{IssueID: issueID, Path: path, Step: i, Err: err}
+		}
+		var t *Transition
+		for j := range transitions {
+			if transitions[j].ID == step.ID && transitions[j].To.ID == step.To.ID {
+				t = &transitions[j]
+			}
+		}
+		if t == nil {
+			err = fmt.Errorf("jira: the transition %q is not available to %s", step.Name, issueID)
+			return path, resp, &TransitionPathError{IssueID: issueID, Path: path, Step: i, Err: err}
+		}
+		path[i] = *t
+
+		var payload *transitionRequest
+		payload, resp, err = s.transitionRequestWithContext(ctx, issueID, t, stepOptions(*t))
+		if err != nil {
+			return path, resp, &TransitionPathError{IssueID: issueID, Path: path, Step: i, Err: err}
+		}
+		resp, err = s.DoTransitionWithPayloadWithContext(ctx, issueID, payload)
+		if err != nil {
+			return path, resp, &TransitionPathError{IssueID: issueID, Path: path, Step: i, Err: err}
+		}
+	}
+	return path, resp, nil
+}
+
+// MoveToStatus wraps MoveToStatusWithContext using the background context.
+func (s *IssueService) MoveToStatus(issueID, target string, options *TransitionPathOptions) ([]Transition, *Response, error) {
+	return s.MoveToStatusWithContext(context.Background(), issueID, target, options)
+}
+
+// findTransitionPathWithContext returns the shortest path of transitions of the issue to the status target
+func (s *IssueService) findTransitionPathWithContext(ctx context.Context, issueID string, issue *Issue, target string, category bool) ([]Transition, *Response, error) {
+	var resp *Response
+	if issue.Fields == nil || issue.Fields.Status == nil {
+		return nil, resp, fmt.Errorf("jira: %s has no status", issueID)
+	}
+	reached := func(status Status) bool {
+		if category {
+			return strings.EqualFold(status.StatusCategory.Key, target) || strings.EqualFold(status.StatusCategory.Name, target)
+		}
+		return status.ID == target || strings.EqualFold(status.Name, target)
+	}
+	start := *issue.Fields.Status
+	if reached(start) {
+		return nil, resp, nil
+	}
+
+	// The transitions available to the issue itself are the first steps
+	first, resp, err := s.GetTransitionsWithContext(ctx, issueID)
+	if err != nil {
+		return nil, resp, err
+	}
+	next, resp, err := s.workflowTransitionsWithContext(ctx, issue, category)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	type node struct {
+		status Status
+		path   []Transition
+	}
+	visited := map[string]bool{start.ID: true}
+	queue := []node{{status: start}}
+	var unexplored []string
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		transitions := first
+		if n.status.ID != start.ID {
+			var explored bool
+			if transitions, explored, resp, err = next(n.status.ID); err != nil {
+				return nil, resp, err
+			}
+			if !explored {
+				unexplored = append(unexplored, fmt.Sprintf("%q", n.status.Name))
+			}
+		}
+		for _, t := range transitions {
+			if visited[t.To.ID] {
+				continue
+			}
+			visited[t.To.ID] = true
+			path := append(append([]Transition{}, n.path...), t)
+			if reached(t.To) {
+				return path, resp, nil
+			}
+			queue = append(queue, node{status: t.To, path: path})
+		}
+	}
+	if len(unexplored) > 0 {
+		return nil, resp, fmt.Errorf("jira: no known transitions lead %s from %q to %q; the workflow could not be read and no other issue of its project and type is in %s to learn the transitions out of",
+			issueID, start.Name, target, strings.Join(unexplored, ", "))
+	}
+	return nil, resp, fmt.Errorf("jira: no transitions lead %s from %q to %q", issueID, start.Name, target)
+}
+
+// workflowTransitionsWithContext returns a function listing the transitions
+// out of a status of the workflow of issue, read from the workflow definition
+// if permitted or else learnt from other issues of its project and type.
+// The function reports false if the transitions out of the status are unknown.
+func (s *IssueService) workflowTransitionsWithContext(ctx context.Context, issue *Issue, category bool) (func(status string) ([]Transition, bool, *Response, error), *Response, error) {
+	byStatus, resp, err := s.workflowDefinitionWithContext(ctx, issue, category)
+	if err == nil {
+		return func(status string) ([]Transition, bool, *Response, error) {
+			// Global transitions, out of any status, are kept under ""
+			return append(byStatus[status], byStatus[""]...), true, resp, nil
+		}, resp, nil
+	}
+	// Without the permission to read workflows Jira answers with a client error
+	if resp == nil || resp.StatusCode >= 500 {
+		return nil, resp, err
+	}
+
+	return func(status string) ([]Transition, bool, *Response, error) {
+		return s.sampleTransitionsWithContext(ctx, issue, status)
+	}, nil, nil
+}
+
+// sampleTransitionsWithContext returns the transitions, with their screens, of
+// another issue of the project and type of issue in status. It reports false
+// if there is no such issue.
+func (s *IssueService) sampleTransitionsWithContext(ctx context.Context, issue *Issue, status string) ([]Transition, bool, *Response, error) {
+	jql := fmt.Sprintf("project = %s AND issuetype = %s AND status = %s AND key != %s",
+		issue.Fields.Project.ID, issue.Fields.Type.ID, status, issue.Key)
+	samples, resp, err := s.SearchWithContext(ctx, jql, &SearchOptions{MaxResults: 1, Fields: []string{"status"}})
+	if err != nil || len(samples) == 0 {
+		return nil, false, resp, err
+	}
+	transitions, resp, err := s.GetTransitionsWithContext(ctx, samples[0].Key)
+	return transitions, err == nil, resp, err
+}
+
+// workflowSchemeProjects is the reply of the workflow schemes of projects
+type workflowSchemeProjects struct {
+	Values []struct {
+		WorkflowScheme struct {
+			DefaultWorkflow   string            `json:"defaultWorkflow"`
+			IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+		} `json:"workflowScheme"`
+	} `json:"values"`
+}
+
+// workflowSearchResult is the reply of the workflow search
+type workflowSearchResult struct {
+	Values []struct {
+		Transitions []struct {
+			ID   string   `json:"id"`
+			Name string   `json:"name"`
+			From []string `json:"from"`
+			To   string   `json:"to"`
+			Type string   `json:"type"`
+		} `json:"transitions"`
+		Statuses []Status `json:"statuses"`
+	} `json:"values"`
+}
+
+// workflowDefinitionWithContext returns the transitions of the workflow of issue by the status they leave
+func (s *IssueService) workflowDefinitionWithContext(ctx context.Context, issue *Issue, category bool) (map[string][]Transition, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/workflowscheme/project?projectId=%s", url.QueryEscape(issue.Fields.Project.ID))
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	schemes := new(workflowSchemeProjects)
+	resp, err := s.client.Do(req, schemes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	if len(schemes.Values) == 0 {
+		return nil, resp, fmt.Errorf("jira: project %s has no workflow scheme", issue.Fields.Project.ID)
+	}
+	scheme := schemes.Values[0].WorkflowScheme
+	workflow, ok := scheme.IssueTypeMappings[issue.Fields.Type.ID]
+	if !ok {
+		workflow = scheme.DefaultWorkflow
+	}
+
+	apiEndpoint = fmt.Sprintf("rest/api/2/workflow/search?workflowName=%s&expand=transitions,statuses", url.QueryEscape(workflow))
+	req, err = s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := new(workflowSearchResult)
+	resp, err = s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	if len(result.Values) == 0 {
+		return nil, resp, fmt.Errorf("jira: no workflow %q", workflow)
+	}
+
+	// The statuses of workflows lack their category
+	statuses := make(map[string]Status)
+	for _, status := range result.Values[0].Statuses {
+		statuses[status.ID] = status
+	}
+	if category {
+		all, resp, err := s.client.Status.GetAllStatusesWithContext(ctx)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, status := range all {
+			statuses[status.ID] = status
+		}
+	}
+
+	byStatus := make(map[string][]Transition)
+	for _, wt := range result.Values[0].Transitions {
+		if wt.Type == "initial" {
+			continue
+		}
+		to, ok := statuses[wt.To]
+		if !ok {
+			to = Status{ID: wt.To}
+		}
+		t := Transition{ID: wt.ID, Name: wt.Name, To: to}
+		if len(wt.From) == 0 {
+			byStatus[""] = append(byStatus[""], t)
+		}
+		for _, from := range wt.From {
+			byStatus[from] = append(byStatus[from], t)
+		}
+	}
+	return byStatus, resp, nil
+}
@@ -0,0 +1,175 @@
+package jira
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// testWorkflow is Open (1) -> Start Progress (11) -> In Progress (3) -> Resolve (31) -> Done (5),
+// with Reopen (41) from anywhere back to Open
+var testWorkflow = map[string]string{
+	"1": `[{"id": "11", "name": "Start Progress", "to": {"id": "3", "name": "In Progress", "statusCategory": {"key": "indeterminate"}}, "fields": {}}]`,
+	"3": `[{"id": "31", "name": "Resolve", "to": {"id": "5", "name": "Done", "statusCategory": {"key": "done", "name": "Done"}}, "fields": {
+		"resolution": {"required": true, "name": "Resolution", "schema": {"type": "resolution"}, "allowedValues": [{"id": "1", "name": "Fixed"}]}}},
+		{"id": "41", "name": "Reopen", "to": {"id": "1", "name": "Open", "statusCategory": {"key": "new"}}, "fields": {}}]`,
+	"5": `[{"id": "41", "name": "Reopen", "to": {"id": "1", "name": "Open", "statusCategory": {"key": "new"}}, "fields": {}}]`,
+}
+
+var testStatuses = map[string]string{
+	"1": `{"id": "1", "name": "Open", "statusCategory": {"key": "new", "name": "To Do"}}`,
+	"3": `{"id": "3", "name": "In Progress", "statusCategory": {"key": "indeterminate", "name": "In Progress"}}`,
+	"5": `{"id": "5", "name": "Done", "statusCategory": {"key": "done", "name": "Done"}}`,
+}
+
+// setupWorkflow fakes PAY-1, in status, and PAY-2 in In Progress; readable tells whether the workflow definition may be read.
+// It returns the number of searches for issues of other statuses.
+func setupWorkflow(t *testing.T, status *string, readable bool) *int {
+	searches := new(int)
+	testMux.HandleFunc("/rest/api/2/issue/PAY-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"key": "PAY-1", "fields": {"status": %s, "issuetype": {"id": "10001"}, "project": {"id": "10000"}}}`, testStatuses[*status])
+	})
+	testMux.HandleFunc("/rest/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[%s, %s, %s]`, testStatuses["1"], testStatuses["3"], testStatuses["5"])
+	})
+	testMux.HandleFunc("/rest/api/2/issue/PAY-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			var payload transitionRequest
+			json.NewDecoder(r.Body).Decode(&payload)
+			*status = map[string]string{"11": "3", "31": "5", "41": "1"}[payload.Transition.ID]
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprintf(w, `{"transitions": %s}`, testWorkflow[*status])
+	})
+	testMux.HandleFunc("/rest/api/2/workflowscheme/project", func(w http.ResponseWriter, r *http.Request) {
+		if !readable {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		testRequestURL(t, r, "/rest/api/2/workflowscheme/project?projectId=10000")
+		fmt.Fprint(w, `{"values": [{"projectIds": [10000], "workflowScheme": {"defaultWorkflow": "jira", "issueTypeMappings": {"10001": "Payments"}}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/workflow/search", func(w http.ResponseWriter, r *http.Request) {
+		testRequestURL(t, r, "/rest/api/2/workflow/search?workflowName=Payments&expand=transitions,statuses")
+		fmt.Fprint(w, `{"values": [{"transitions": [
+			{"id": "1", "name": "Create", "from": [], "to": "1", "type": "initial"},
+			{"id": "11", "name": "Start Progress", "from": ["1"], "to": "3", "type": "directed"},
+			{"id": "31", "name": "Resolve", "from": ["3"], "to": "5", "type": "directed"},
+			{"id": "41", "name": "Reopen", "from": [], "to": "1", "type": "global"}],
+			"statuses": [{"id": "1", "name": "Open"}, {"id": "3", "name": "In Progress"}, {"id": "5", "name": "Done"}]}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		*searches++
+		switch jql := r.URL.Query().Get("jql"); jql {
+		case "project = 10000 AND issuetype = 10001 AND status = 3 AND key != PAY-1":
+			fmt.Fprint(w, `{"issues": [{"key": "PAY-2"}]}`)
+		case "project = 10000 AND issuetype = 10001 AND status = 1 AND key != PAY-1",
+			"project = 10000 AND issuetype = 10001 AND status = 5 AND key != PAY-1":
+			fmt.Fprint(w, `{"issues": []}`)
+		default:
+			t.Errorf("Unexpected JQL %q", jql)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/issue/PAY-2/transitions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"transitions": %s}`, testWorkflow["3"])
+	})
+	return searches
+}
+
+func resolveFixed(step Transition) []TransitionOption {
+	if step.Name == "Resolve" {
+		return []TransitionOption{TransitionWithField("resolution", "Fixed")}
+	}
+	return nil
+}
+
+func TestIssueService_MoveToStatus(t *testing.T) {
+	for _, readable := range []bool{true, false} {
+		setup()
+		status := "1"
+		searches := setupWorkflow(t, &status, readable)
+
+		path, _, err := testClient.Issue.MoveToStatus("PAY-1", "done", &TransitionPathOptions{StepOptions: resolveFixed})
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if len(path) != 2 || path[0].ID != "11" || path[1].ID != "31" {
+			t.Errorf("Expected the path Start Progress, Resolve, got %+v", path)
+		}
+		if status != "5" {
+			t.Errorf("Expected PAY-1 to be Done, got the status %s", status)
+		}
+		if readable && *searches != 0 {
+			t.Errorf("Expected the workflow definition to be used, got %d searches", *searches)
+		}
+
+		path, _, err = testClient.Issue.MoveToStatus("PAY-1", StatusCategoryComplete, &TransitionPathOptions{Category: true})
+		if err != nil || len(path) != 0 {
+			t.Errorf("Expected PAY-1 to be done already, got %+v, %v", path, err)
+		}
+		teardown()
+	}
+}
+
+func TestIssueService_MoveToStatus_fields(t *testing.T) {
+	// The screen of the second step is read on PAY-2 either way
+	for _, readable := range []bool{false, true} {
+		setup()
+		status := "1"
+		setupWorkflow(t, &status, readable)
+
+		path, _, err := testClient.Issue.MoveToStatus("PAY-1", "Done", &TransitionPathOptions{DryRun: true})
+		var pathErr *TransitionPathError
+		var fieldsErr *TransitionFieldsError
+		if !errors.As(err, &pathErr) || !errors.As(err, &fieldsErr) {
+			t.Fatalf("Expected a *TransitionPathError of a *TransitionFieldsError, got %v", err)
+		}
+		if pathErr.Step != 1 || len(path) != 2 || status != "1" {
+			t.Errorf("Expected the dry run to fail at the second step without moving PAY-1, got step %d of %+v, status %s", pathErr.Step, path, status)
+		}
+		want := `jira: step 2 of 2 moving PAY-1, "Resolve" to "Done", failed: jira: transition "Resolve" of PAY-1 requires the fields Resolution (resolution) of "Fixed"`
+		if err.Error() != want {
+			t.Errorf("Unexpected error %q, want %q", err, want)
+		}
+		teardown()
+	}
+
+	// No issue is Open to read the screen of Start Progress on
+	setup()
+	status := "5"
+	setupWorkflow(t, &status, true)
+	path, _, err := testClient.Issue.MoveToStatus("PAY-1", "In Progress", &TransitionPathOptions{DryRun: true})
+	if err != nil || len(path) != 2 || path[0].Fields == nil || path[1].Fields != nil {
+		t.Errorf("Expected Reopen checked and Start Progress unchecked, got %+v, %v", path, err)
+	}
+	teardown()
+
+	setup()
+	defer teardown()
+	status = "1"
+	setupWorkflow(t, &status, false)
+
+	if _, _, err := testClient.Issue.MoveToStatus("PAY-1", "Done", &TransitionPathOptions{DryRun: true, StepOptions: resolveFixed}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	// No issue is Done to learn the transitions out of it on
+	want := `jira: no known transitions lead PAY-1 from "Open" to "Closed"; the workflow could not be read and no other issue of its project and type is in "Done" to learn the transitions out of`
+	if _, _, err := testClient.Issue.MoveToStatus("PAY-1", "Closed", nil); err == nil || err.Error() != want {
+		t.Errorf("Expected no known path, got %v", err)
+	}
+}
+
+func TestIssueService_MoveToStatus_noPath(t *testing.T) {
+	setup()
+	defer teardown()
+	status := "1"
+	setupWorkflow(t, &status, true)
+
+	if _, _, err := testClient.Issue.MoveToStatus("PAY-1", "Closed", nil); err == nil || err.Error() != `jira: no transitions lead PAY-1 from "Open" to "Closed"` {
+		t.Errorf("Expected no path, got %v", err)
+	}
+}
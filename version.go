@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"time"
 )
 
 // VersionService handles Versions for the Jira instance / API.
@@ -28,6 +29,69 @@ type Version struct {
 	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
 }
 
+// VersionListOptions specifies the optional parameters to the VersionService.GetList
+type VersionListOptions struct {
+	// Query filters results to versions whose name or description contains the query
+	Query string `url:"query,omitempty"`
+	// OrderBy orders the results by a field, e.g. "sequence", "name" or "-releaseDate"
+	OrderBy string `url:"orderBy,omitempty"`
+	// Status filters results to versions in the specified states, comma-separate list.
+	// Valid values: released, unreleased, archived.
+	Status string `url:"status,omitempty"`
+	// StartAt is the index of the first item returned, base index 0
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults is the maximum number of items returned per page, default 50
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// VersionsList reflects a page of the versions of a project
+type VersionsList struct {
+	MaxResults int       `json:"maxResults" structs:"maxResults"`
+	StartAt    int       `json:"startAt" structs:"startAt"`
+	Total      int       `json:"total" structs:"total"`
+	IsLast     bool      `json:"isLast" structs:"isLast"`
+	Values     []Version `json:"values" structs:"values"`
+}
+
+// ReleaseVersionOptions are passed to the VersionService.Release function
+type ReleaseVersionOptions struct {
+	// ReleaseDate in the format 2006-01-02, defaults to today
+	ReleaseDate string
+	// MoveUnfixedIssuesTo is the ID of the version the unresolved issues are moved to.
+	// If 0, they keep the released version as fix version.
+	MoveUnfixedIssuesTo int
+}
+
+// DeleteVersionOptions specifies the versions that replace a deleted version
+// in the fix version and affects version fields of its issues.
+// If not set, the deleted version is just removed from the issues.
+type DeleteVersionOptions struct {
+	MoveFixIssuesTo      int `url:"moveFixIssuesTo,omitempty"`
+	MoveAffectedIssuesTo int `url:"moveAffectedIssuesTo,omitempty"`
+}
+
+// VersionMoveOptions describes where a version is moved to in the order of the project versions.
+// Either Position ("First", "Last", "Earlier" or "Later") or After (the ID of the version the moved version follows) is set.
+type VersionMoveOptions struct {
+	Position string
+	After    int
+}
+
+// VersionIssueCounts holds the number of issues related to a version
+type VersionIssueCounts struct {
+	Self                                     string `json:"self" structs:"self"`
+	IssuesFixedCount                         int    `json:"issuesFixedCount" structs:"issuesFixedCount"`
+	IssuesAffectedCount                      int    `json:"issuesAffectedCount" structs:"issuesAffectedCount"`
+	IssueCountWithCustomFieldsShowingVersion int    `json:"issueCountWithCustomFieldsShowingVersion" structs:"issueCountWithCustomFieldsShowingVersion"`
+}
+
+// VersionUnresolvedIssueCount holds the number of unresolved issues of a version
+type VersionUnresolvedIssueCount struct {
+	Self                  string `json:"self" structs:"self"`
+	IssuesUnresolvedCount int    `json:"issuesUnresolvedCount" structs:"issuesUnresolvedCount"`
+	IssuesCount           int    `json:"issuesCount" structs:"issuesCount"`
+}
+
 // GetWithContext gets version info from Jira
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-get
@@ -111,3 +175,227 @@ func (s *VersionService) UpdateWithContext(ctx context.Context, version *Version
 func (s *VersionService) Update(version *Version) (*Version, *Response, error) {
 	return s.UpdateWithContext(context.Background(), version)
 }
+
+// versionURL returns the URL of a version, which some version resources expect
+// instead of an ID to refer to another version
+func (s *VersionService) versionURL(versionID int) string {
+	return fmt.Sprintf("%srest/api/2/version/%d", s.client.baseURL.String(), versionID)
+}
+
+// GetListWithContext returns a page of the versions of the project identified by projectID (id or key)
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/project-getProjectVersionsPaginated
+func (s *VersionService) GetListWithContext(ctx context.Context, projectID string, options *VersionListOptions) (*VersionsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s/version", projectID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions := new(VersionsList)
+	resp, err := s.client.Do(req, versions)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return versions, resp, nil
+}
+
+// GetList wraps GetListWithContext using the background context.
+func (s *VersionService) GetList(projectID string, options *VersionListOptions) (*VersionsList, *Response, error) {
+	return s.GetListWithContext(context.Background(), projectID, options)
+}
+
+// updateWithContext sends a partial version update and returns the updated version
+func (s *VersionService) updateWithContext(ctx context.Context, versionID int, body interface{}) (*Version, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%d", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return version, resp, nil
+}
+
+// ReleaseWithContext marks a version as released. If options.MoveUnfixedIssuesTo
+// is set, the unresolved issues of the version are moved to that version.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-put
+func (s *VersionService) ReleaseWithContext(ctx context.Context, versionID int, options *ReleaseVersionOptions) (*Version, *Response, error) {
+	if options == nil {
+		options = &ReleaseVersionOptions{}
+	}
+	body := struct {
+		Released            bool   `json:"released"`
+		ReleaseDate         string `json:"releaseDate"`
+		MoveUnfixedIssuesTo string `json:"moveUnfixedIssuesTo,omitempty"`
+	}{
+		Released:    true,
+		ReleaseDate: options.ReleaseDate,
+	}
+	if body.ReleaseDate == "" {
+		body.ReleaseDate = time.Now().Format("2006-01-02")
+	}
+	if options.MoveUnfixedIssuesTo != 0 {
+		body.MoveUnfixedIssuesTo = s.versionURL(options.MoveUnfixedIssuesTo)
+	}
+	return s.updateWithContext(ctx, versionID, body)
+}
+
+// Release wraps ReleaseWithContext using the background context.
+func (s *VersionService) Release(versionID int, options *ReleaseVersionOptions) (*Version, *Response, error) {
+	return s.ReleaseWithContext(context.Background(), versionID, options)
+}
+
+// ArchiveWithContext archives a version
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-version-id-put
+func (s *VersionService) ArchiveWithContext(ctx context.Context, versionID int) (*Version, *Response, error) {
+	body := struct {
+		Archived bool `json:"archived"`
+	}{true}
+	return s.updateWithContext(ctx, versionID, body)
+}
+
+// Archive wraps ArchiveWithContext using the background context.
+func (s *VersionService) Archive(versionID int) (*Version, *Response, error) {
+	return s.ArchiveWithContext(context.Background(), versionID)
+}
+
+// DeleteWithContext deletes a version. The fix and affects versions of its
+// issues are swapped to the versions given in options, if any.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/version-delete
+func (s *VersionService) DeleteWithContext(ctx context.Context, versionID int, options *DeleteVersionOptions) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%d", versionID)
+	url, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Delete wraps DeleteWithContext using the background context.
+func (s *VersionService) Delete(versionID int, options *DeleteVersionOptions) (*Response, error) {
+	return s.DeleteWithContext(context.Background(), versionID, options)
+}
+
+// MergeWithContext merges a version into the version moveIssuesTo.
+// The issues of the version are moved and the version is deleted.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/version-merge
+func (s *VersionService) MergeWithContext(ctx context.Context, versionID, moveIssuesTo int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%d/mergeto/%d", versionID, moveIssuesTo)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// Merge wraps MergeWithContext using the background context.
+func (s *VersionService) Merge(versionID, moveIssuesTo int) (*Response, error) {
+	return s.MergeWithContext(context.Background(), versionID, moveIssuesTo)
+}
+
+// MoveWithContext changes the position of a version in the order of the project versions.
+// It returns an error, without a request, if options set neither Position nor After.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/version-moveVersion
+func (s *VersionService) MoveWithContext(ctx context.Context, versionID int, options *VersionMoveOptions) (*Version, *Response, error) {
+	if options == nil || (options.Position == "" && options.After == 0) {
+		return nil, nil, fmt.Errorf("jira: moving version %d needs a Position or After", versionID)
+	}
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%d/move", versionID)
+	body := struct {
+		Position string `json:"position,omitempty"`
+		After    string `json:"after,omitempty"`
+	}{Position: options.Position}
+	if options.After != 0 {
+		body.After = s.versionURL(options.After)
+	}
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(Version)
+	resp, err := s.client.Do(req, version)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return version, resp, nil
+}
+
+// Move wraps MoveWithContext using the background context.
+func (s *VersionService) Move(versionID int, options *VersionMoveOptions) (*Version, *Response, error) {
+	return s.MoveWithContext(context.Background(), versionID, options)
+}
+
+// GetRelatedIssueCountsWithContext returns the number of issues that have a version as fix or affects version
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/version-getVersionRelatedIssues
+func (s *VersionService) GetRelatedIssueCountsWithContext(ctx context.Context, versionID int) (*VersionIssueCounts, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%d/relatedIssueCounts", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := new(VersionIssueCounts)
+	resp, err := s.client.Do(req, counts)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return counts, resp, nil
+}
+
+// GetRelatedIssueCounts wraps GetRelatedIssueCountsWithContext using the background context.
+func (s *VersionService) GetRelatedIssueCounts(versionID int) (*VersionIssueCounts, *Response, error) {
+	return s.GetRelatedIssueCountsWithContext(context.Background(), versionID)
+}
+
+// GetUnresolvedIssueCountWithContext returns the number of unresolved issues of a version
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.5.0/#api/2/version-getVersionUnresolvedIssues
+func (s *VersionService) GetUnresolvedIssueCountWithContext(ctx context.Context, versionID int) (*VersionUnresolvedIssueCount, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/version/%d/unresolvedIssueCount", versionID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(VersionUnresolvedIssueCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return count, resp, nil
+}
+
+// GetUnresolvedIssueCount wraps GetUnresolvedIssueCountWithContext using the background context.
+func (s *VersionService) GetUnresolvedIssueCount(versionID int) (*VersionUnresolvedIssueCount, *Response, error) {
+	return s.GetUnresolvedIssueCountWithContext(context.Background(), versionID)
+}
@@ -1,9 +1,12 @@
 package jira
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestVersionService_Get_Success(t *testing.T) {
@@ -109,3 +112,202 @@ func TestServiceService_Update(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestVersionService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	raw, err := ioutil.ReadFile("./mocks/project_versions.json")
+	if err != nil {
+		t.Error(err.Error())
+	}
+	testMux.HandleFunc("/rest/api/2/project/PAY/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testRequestParams(t, r, map[string]string{"status": "unreleased", "orderBy": "sequence"})
+		fmt.Fprint(w, string(raw))
+	})
+
+	versions, _, err := testClient.Version.GetList("PAY", &VersionListOptions{Status: "unreleased", OrderBy: "sequence"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if versions == nil || len(versions.Values) != 2 || !versions.IsLast {
+		t.Errorf("Expected the last page with 2 versions, got %+v", versions)
+	}
+}
+
+func TestVersionService_Release(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["released"] != true || body["releaseDate"] != "2020-06-01" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		if want := testServer.URL + "/rest/api/2/version/10011"; body["moveUnfixedIssuesTo"] != want {
+			t.Errorf("Expected unfixed issues to be moved to %s, got %v", want, body["moveUnfixedIssuesTo"])
+		}
+		fmt.Fprint(w, `{"id": "10010", "name": "1.4", "released": true, "releaseDate": "2020-06-01"}`)
+	})
+
+	version, _, err := testClient.Version.Release(10010, &ReleaseVersionOptions{ReleaseDate: "2020-06-01", MoveUnfixedIssuesTo: 10011})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if version == nil || !version.Released {
+		t.Errorf("Expected released version, got %+v", version)
+	}
+}
+
+func TestVersionService_Release_Today(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if today := time.Now().Format("2006-01-02"); body["releaseDate"] != today {
+			t.Errorf("Expected release date %s, got %v", today, body["releaseDate"])
+		}
+		if _, ok := body["moveUnfixedIssuesTo"]; ok {
+			t.Error("Expected no moveUnfixedIssuesTo")
+		}
+		fmt.Fprint(w, `{"id": "10010", "released": true}`)
+	})
+
+	if _, _, err := testClient.Version.Release(10010, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["archived"] != true {
+			t.Errorf("Unexpected body %v", body)
+		}
+		fmt.Fprint(w, `{"id": "10010", "archived": true}`)
+	})
+
+	version, _, err := testClient.Version.Archive(10010)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if version == nil || !version.Archived {
+		t.Errorf("Expected archived version, got %+v", version)
+	}
+}
+
+func TestVersionService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testRequestParams(t, r, map[string]string{"moveFixIssuesTo": "10011", "moveAffectedIssuesTo": "10009"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Version.Delete(10010, &DeleteVersionOptions{MoveFixIssuesTo: 10011, MoveAffectedIssuesTo: 10009})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_Merge(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/mergeto/10011", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Version.Merge(10010, 10011); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_Move(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body) != 1 || body["position"] != "First" {
+			t.Errorf("Unexpected body %v", body)
+		}
+		fmt.Fprint(w, `{"id": "10010", "name": "1.4"}`)
+	})
+
+	if _, _, err := testClient.Version.Move(10010, &VersionMoveOptions{Position: "First"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestVersionService_Move_Failure(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/move", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": [], "errors": {"after": "Version does not exist"}}`)
+	})
+
+	version, _, err := testClient.Version.Move(10010, &VersionMoveOptions{After: 99999})
+	if version != nil {
+		t.Errorf("Expected nil. Got %+v", version)
+	}
+	if err == nil {
+		t.Error("No error given")
+	}
+}
+
+func TestVersionService_Move_NoPosition(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/move", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request")
+	})
+
+	for _, options := range []*VersionMoveOptions{nil, {}} {
+		if _, _, err := testClient.Version.Move(10010, options); err == nil {
+			t.Errorf("Expected an error for %+v", options)
+		}
+	}
+}
+
+func TestVersionService_IssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/version/10010/relatedIssueCounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/version/10010", "issuesFixedCount": 23, "issuesAffectedCount": 101, "issueCountWithCustomFieldsShowingVersion": 54}`)
+	})
+	testMux.HandleFunc("/rest/api/2/version/10010/unresolvedIssueCount", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"self": "http://www.example.com/jira/rest/api/2/version/10010", "issuesUnresolvedCount": 4, "issuesCount": 30}`)
+	})
+
+	counts, _, err := testClient.Version.GetRelatedIssueCounts(10010)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if counts == nil || counts.IssuesFixedCount != 23 || counts.IssuesAffectedCount != 101 {
+		t.Errorf("Unexpected counts %+v", counts)
+	}
+
+	unresolved, _, err := testClient.Version.GetUnresolvedIssueCount(10010)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if unresolved == nil || unresolved.IssuesUnresolvedCount != 4 {
+		t.Errorf("Unexpected count %+v", unresolved)
+	}
+}